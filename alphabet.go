@@ -0,0 +1,92 @@
+package strconvlen
+
+import (
+	"math/bits"
+)
+
+// Alphabet is a set of digits, where the number of digits is the base. Each
+// digit must be a single byte.
+type Alphabet string
+
+// Common alphabets.
+const (
+	// BigAlphabet is the alphabet of big.Int.Text, which supports bases up to
+	// 62. The first 36 digits are the same as those of strconv.
+	BigAlphabet Alphabet = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	// Base58Alphabet is the Bitcoin base58 alphabet.
+	Base58Alphabet Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+)
+
+// Base returns the number of digits in a.
+func (a Alphabet) Base() int {
+	return len(a)
+}
+
+// Uint64 returns the length of n after it is formatted with the digits of a.
+func (a Alphabet) Uint64(n uint64) int {
+	if len(a) < 2 || len(a) > 256 {
+		panic("strconvlen: illegal Alphabet base")
+	}
+	return uintNLen(n, len(a))
+}
+
+// Int64 returns the length of n after it is formatted with the digits of a,
+// including the minus sign of negative numbers.
+func (a Alphabet) Int64(n int64) int {
+	if n < 0 {
+		return a.Uint64(uint64(-n)) + 1
+	}
+	return a.Uint64(uint64(n))
+}
+
+// UintN returns the same result as len(new(big.Int).SetUint64(n).Text(base)).
+// Unlike Uint64, it accepts bases up to 62.
+func UintN(n uint64, base int) int {
+	if base < 2 || base > 62 {
+		panic("strconvlen: illegal UintN base")
+	}
+	return uintNLen(n, base)
+}
+
+// IntN returns the same result as len(big.NewInt(n).Text(base)). Unlike
+// Int64, it accepts bases up to 62.
+func IntN(n int64, base int) int {
+	if base < 2 || base > 62 {
+		panic("strconvlen: illegal IntN base")
+	}
+	if n < 0 {
+		return uintNLen(uint64(-n), base) + 1
+	}
+	return uintNLen(uint64(n), base)
+}
+
+// uintNLen returns the number of digits of n in base, where base is between
+// 2 and 256.
+func uintNLen(n uint64, base int) int {
+	switch {
+	case base <= 36:
+		return Uint64(n, base)
+	case base < len(powTables):
+		return powLen(n, base)
+	}
+
+	bbase := uint64(base)
+	if n < bbase {
+		return 1
+	}
+
+	// Bases beyond the power tables multiply up to the largest power of base
+	// that fits into a uint64.
+	mbase := bbase
+	for i := 2; ; i++ {
+		hi, lo := bits.Mul64(mbase, bbase)
+		if hi != 0 {
+			// n is always less than base^i
+			return i
+		}
+		mbase = lo
+		if n < mbase {
+			return i
+		}
+	}
+}
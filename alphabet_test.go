@@ -0,0 +1,95 @@
+package strconvlen
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestUintN(t *testing.T) {
+	const maxPlaces = 20
+
+	for i := 2; i <= 62; i++ {
+		vlen := UintN(0, i)
+		vstr := big.NewInt(0).Text(i)
+		if len(vstr) != vlen {
+			t.Errorf("expect UintN(v: %d, base: %d) == len(%q) == %d but got %d",
+				0, i, vstr, len(vstr), vlen)
+		}
+
+		samples := powBoundaries(i)
+		for j := 1; j <= maxPlaces; j++ {
+			samples = append(samples, randUint64WithPlaces(j))
+		}
+		for _, v := range samples {
+			vlen := UintN(v, i)
+			vstr := new(big.Int).SetUint64(v).Text(i)
+			if len(vstr) != vlen {
+				t.Errorf("expect UintN(v: %d, base: %d) == len(%q) == %d but got %d",
+					v, i, vstr, len(vstr), vlen)
+			}
+		}
+	}
+}
+
+func TestIntN(t *testing.T) {
+	const maxPlaces = 19
+
+	for i := 2; i <= 62; i++ {
+		samples := []int64{0, math.MinInt64, math.MaxInt64}
+		for j := 1; j <= maxPlaces; j++ {
+			v := int64(randIntWithPlaces(j, 0, 0))
+			samples = append(samples, v, -v)
+		}
+		for _, v := range samples {
+			vlen := IntN(v, i)
+			vstr := big.NewInt(v).Text(i)
+			if len(vstr) != vlen {
+				t.Errorf("expect IntN(v: %d, base: %d) == len(%q) == %d but got %d",
+					v, i, vstr, len(vstr), vlen)
+			}
+		}
+	}
+}
+
+func TestAlphabet(t *testing.T) {
+	alphabets := []Alphabet{
+		"01",
+		BigAlphabet,
+		Base58Alphabet,
+		"ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_",
+		Alphabet(strings.Repeat("x", 255)),
+		Alphabet(strings.Repeat("x", 256)),
+	}
+
+	for _, a := range alphabets {
+		samples := []uint64{0, 1, uint64(a.Base() - 1), uint64(a.Base()), math.MaxUint64}
+		for j := 1; j <= 20; j++ {
+			samples = append(samples, randUint64WithPlaces(j))
+		}
+		for _, v := range samples {
+			want := refUintDigits(v, a.Base())
+			if l := a.Uint64(v); l != want {
+				t.Errorf("expect Alphabet(base: %d).Uint64(%d) == %d but got %d", a.Base(), v, want, l)
+			}
+			if v <= math.MaxInt64 {
+				if l := a.Int64(-int64(v)); v != 0 && l != want+1 {
+					t.Errorf("expect Alphabet(base: %d).Int64(-%d) == %d but got %d", a.Base(), v, want+1, l)
+				}
+			}
+		}
+	}
+}
+
+// helpers
+
+// refUintDigits returns the number of digits of n in base by division.
+func refUintDigits(n uint64, base int) int {
+	digits := 1
+	for n >= uint64(base) {
+		n /= uint64(base)
+		digits++
+	}
+	return digits
+}
@@ -0,0 +1,81 @@
+package strconvlen
+
+// Base58 returns the length of src after it is encoded in base58 with the
+// Bitcoin alphabet, where each leading zero byte is encoded as '1'.
+func Base58(src []byte) int {
+	return radixLen(src, 58)
+}
+
+// Base62 returns the length of src after it is encoded in base62 as a
+// big-endian number, where each leading zero byte is encoded as the zero
+// digit like Base58 does.
+func Base62(src []byte) int {
+	return radixLen(src, 62)
+}
+
+// Uint64Base62 returns the length of n in base62, which is what Uint64 would
+// return if strconv supported base 62.
+func Uint64Base62(n uint64) int {
+	return powLen(n, 62)
+}
+
+// radixLen returns the length of src after it is encoded as a big-endian
+// number in base, where each leading zero byte is encoded as the zero digit
+// like Bitcoin's base58 does.
+func radixLen(src []byte, base uint32) int {
+	zeros := 0
+	for zeros < len(src) && src[zeros] == 0 {
+		zeros++
+	}
+	src = src[zeros:]
+	if len(src) == 0 {
+		return zeros
+	}
+
+	// Divide by the largest power of base that fits into a uint32, which
+	// yields that many digits at a time.
+	chunk, chunkDigits := uint64(base), 1
+	for chunk*uint64(base) <= 1<<32 {
+		chunk *= uint64(base)
+		chunkDigits++
+	}
+
+	// Load src into big-endian 32 bit words.
+	var buf [8]uint32
+	words := buf[:0]
+	if l := (len(src) + 3) / 4; l > len(buf) {
+		words = make([]uint32, 0, l)
+	}
+	if r := len(src) % 4; r != 0 {
+		var w uint32
+		for _, c := range src[:r] {
+			w = w<<8 | uint32(c)
+		}
+		words = append(words, w)
+		src = src[r:]
+	}
+	for i := 0; i < len(src); i += 4 {
+		words = append(words, uint32(src[i])<<24|uint32(src[i+1])<<16|uint32(src[i+2])<<8|uint32(src[i+3]))
+	}
+
+	n := 0
+	for {
+		var rem uint64
+		for i, w := range words {
+			cur := rem<<32 | uint64(w)
+			words[i] = uint32(cur / chunk)
+			rem = cur % chunk
+		}
+		for len(words) > 0 && words[0] == 0 {
+			words = words[1:]
+		}
+		if len(words) == 0 {
+			// The last remainder has no leading zero digits.
+			for ; rem > 0; rem /= uint64(base) {
+				n++
+			}
+			return zeros + n
+		}
+		n += chunkDigits
+	}
+}
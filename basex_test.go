@@ -0,0 +1,62 @@
+package strconvlen
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestBase58(t *testing.T) {
+	for _, src := range randRadixSamples() {
+		want := refRadixEncode(src, string(Base58Alphabet))
+		if l := Base58(src); l != len(want) {
+			t.Errorf("expect Base58(%x) == len(%q) == %d but got %d", src, want, len(want), l)
+		}
+	}
+}
+
+func TestBase62(t *testing.T) {
+	for _, src := range randRadixSamples() {
+		want := refRadixEncode(src, string(BigAlphabet))
+		if l := Base62(src); l != len(want) {
+			t.Errorf("expect Base62(%x) == len(%q) == %d but got %d", src, want, len(want), l)
+		}
+	}
+}
+
+func TestUint64Base62(t *testing.T) {
+	samples := []uint64{0, 61, 62, math.MaxUint64}
+	for i := 0; i <= 64; i++ {
+		samples = append(samples, randUint64WithBits(i)...)
+	}
+
+	pow := uint64(1)
+	for i := 1; i <= 10; i++ {
+		pow *= 62
+		samples = append(samples, pow-1, pow)
+	}
+
+	for _, v := range samples {
+		want := new(big.Int).SetUint64(v).Text(62)
+		if l := Uint64Base62(v); l != len(want) {
+			t.Errorf("expect Uint64Base62(%d) == len(%q) == %d but got %d", v, want, len(want), l)
+		}
+	}
+}
+
+// helpers
+
+// randRadixSamples returns byte slices of various lengths, some of which have
+// leading zeros.
+func randRadixSamples() [][]byte {
+	samples := [][]byte{nil, {0}, {0, 0}, {1}, {0, 0, 1}, {0xff}}
+	for _, l := range []int{1, 2, 3, 4, 5, 7, 8, 16, 31, 32, 33, 64, 100} {
+		for i := 0; i < 10; i++ {
+			src := make([]byte, l)
+			rand.Read(src[rand.Intn(l/2+1):])
+			samples = append(samples, src)
+		}
+	}
+	return samples
+}
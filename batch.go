@@ -0,0 +1,99 @@
+package strconvlen
+
+// SumUint64 returns the sum of Uint64(n, base) for every n in ns.
+//
+// The base is checked once for the whole slice, and every element is looked
+// up in the power tables without branching on the base, so it is faster than
+// calling Uint64 in a loop when sizing a whole column of numbers.
+func SumUint64(ns []uint64, base int) int {
+	if base < 2 || base > 36 {
+		panic("strconvlen: illegal SumUint64 base")
+	}
+
+	sum := 0
+	for _, n := range ns {
+		sum += powLen(n, base)
+	}
+	return sum
+}
+
+// LensUint64 appends Uint64(n, base) for every n in ns to dst and returns the
+// extended slice.
+func LensUint64(dst []int, ns []uint64, base int) []int {
+	if base < 2 || base > 36 {
+		panic("strconvlen: illegal LensUint64 base")
+	}
+
+	dst, lens := growInts(dst, len(ns))
+	for i, n := range ns {
+		lens[i] = powLen(n, base)
+	}
+	return dst
+}
+
+// SumInt64 returns the sum of Int64(n, base) for every n in ns.
+func SumInt64(ns []int64, base int) int {
+	if base < 2 || base > 36 {
+		panic("strconvlen: illegal SumInt64 base")
+	}
+
+	sum := 0
+	for _, n := range ns {
+		sum += int64Len(n, base)
+	}
+	return sum
+}
+
+// LensInt64 appends Int64(n, base) for every n in ns to dst and returns the
+// extended slice.
+func LensInt64(dst []int, ns []int64, base int) []int {
+	if base < 2 || base > 36 {
+		panic("strconvlen: illegal LensInt64 base")
+	}
+
+	dst, lens := growInts(dst, len(ns))
+	for i, n := range ns {
+		lens[i] = int64Len(n, base)
+	}
+	return dst
+}
+
+// SumFloat64 returns the sum of Float64(f, fmt, prec, bitSize) for every f in
+// fs.
+func SumFloat64(fs []float64, fmt byte, prec, bitSize int) int {
+	sum := 0
+	for _, f := range fs {
+		sum += Float64(f, fmt, prec, bitSize)
+	}
+	return sum
+}
+
+// LensFloat64 appends Float64(f, fmt, prec, bitSize) for every f in fs to dst
+// and returns the extended slice.
+func LensFloat64(dst []int, fs []float64, fmt byte, prec, bitSize int) []int {
+	dst, lens := growInts(dst, len(fs))
+	for i, f := range fs {
+		lens[i] = Float64(f, fmt, prec, bitSize)
+	}
+	return dst
+}
+
+// int64Len is the same as Int64, without checking base. The sign and the
+// magnitude are computed without branching.
+func int64Len(n int64, base int) int {
+	sign := n >> 63 // 0 or -1
+	return powLen(uint64((n^sign)-sign), base) + int(sign&1)
+}
+
+// growInts extends dst by n elements, and returns the extended slice and the
+// new elements.
+func growInts(dst []int, n int) ([]int, []int) {
+	l := len(dst)
+	if cap(dst)-l < n {
+		grown := make([]int, l, l+n)
+		copy(grown, dst)
+		dst = grown
+	}
+	dst = dst[:l+n]
+	return dst, dst[l:]
+}
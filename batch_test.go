@@ -0,0 +1,98 @@
+package strconvlen
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSumUint64(t *testing.T) {
+	for i := 2; i <= 36; i++ {
+		ns := powBoundaries(i)
+		for j := 1; j <= 20; j++ {
+			ns = append(ns, randUint64WithPlaces(j))
+		}
+
+		want := 0
+		for _, n := range ns {
+			want += Uint64(n, i)
+		}
+		if got := SumUint64(ns, i); got != want {
+			t.Errorf("expect SumUint64(base: %d) == %d but got %d", i, want, got)
+		}
+
+		dst := []int{-1}
+		dst = LensUint64(dst, ns, i)
+		if len(dst) != len(ns)+1 || dst[0] != -1 {
+			t.Fatalf("expect LensUint64 to append %d lengths to dst but got %v", len(ns), dst)
+		}
+		for j, n := range ns {
+			if want := Uint64(n, i); dst[j+1] != want {
+				t.Errorf("expect LensUint64(v: %d, base: %d) == %d but got %d", n, i, want, dst[j+1])
+			}
+		}
+	}
+}
+
+func TestSumInt64(t *testing.T) {
+	for i := 2; i <= 36; i++ {
+		ns := []int64{0, -1, 1, math.MinInt64, math.MaxInt64}
+		for _, v := range powBoundaries(i) {
+			if v <= math.MaxInt64 {
+				ns = append(ns, int64(v), -int64(v))
+			}
+		}
+		for j := 1; j <= 19; j++ {
+			v := int64(randIntWithPlaces(j, 0, 0))
+			ns = append(ns, v, -v)
+		}
+
+		want := 0
+		for _, n := range ns {
+			want += Int64(n, i)
+		}
+		if got := SumInt64(ns, i); got != want {
+			t.Errorf("expect SumInt64(base: %d) == %d but got %d", i, want, got)
+		}
+
+		dst := LensInt64(make([]int, 0, len(ns)), ns, i)
+		if len(dst) != len(ns) {
+			t.Fatalf("expect LensInt64 to append %d lengths to dst but got %v", len(ns), dst)
+		}
+		for j, n := range ns {
+			if want := Int64(n, i); dst[j] != want {
+				t.Errorf("expect LensInt64(v: %d, base: %d) == %d but got %d", n, i, want, dst[j])
+			}
+		}
+	}
+}
+
+func TestSumFloat64(t *testing.T) {
+	fs := []float64{0, math.Copysign(0, -1), 1, -1, 0.1, 1e21, 1e-7,
+		math.MaxFloat64, math.SmallestNonzeroFloat64, math.Inf(1), math.Inf(-1), math.NaN()}
+	for i := 0; i < 20; i++ {
+		fs = append(fs, randFloat64())
+	}
+
+	for _, fmt := range []byte{'e', 'f', 'g'} {
+		for _, prec := range []int{-1, 0, 3, 40} {
+			want := 0
+			for _, f := range fs {
+				want += Float64(f, fmt, prec, 64)
+			}
+			if got := SumFloat64(fs, fmt, prec, 64); got != want {
+				t.Errorf("expect SumFloat64(fmt: %c, prec: %d) == %d but got %d", fmt, prec, want, got)
+			}
+
+			dst := LensFloat64(nil, fs, fmt, prec, 64)
+			if len(dst) != len(fs) {
+				t.Fatalf("expect LensFloat64 to append %d lengths to dst but got %v", len(fs), dst)
+			}
+			for j, f := range fs {
+				if want := Float64(f, fmt, prec, 64); dst[j] != want {
+					t.Errorf("expect LensFloat64(f: %v, fmt: %c, prec: %d) == %d but got %d",
+						f, fmt, prec, want, dst[j])
+				}
+			}
+		}
+	}
+}
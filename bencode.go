@@ -0,0 +1,25 @@
+package strconvlen
+
+// BencodeInt returns the length of n after it is encoded as a bencode
+// integer, i.e. "i" + strconv.FormatInt(n, 10) + "e".
+func BencodeInt(n int64) int {
+	return Int64(n, 10) + 2
+}
+
+// BencodeString returns the length of a bencode string, i.e. the decimal
+// length prefix, a colon and the n bytes of the string itself.
+func BencodeString(n int) int {
+	if n < 0 {
+		panic("strconvlen: illegal BencodeString length")
+	}
+	return Int(n, 10) + 1 + n
+}
+
+// Netstring returns the length of a netstring with a payload of n bytes,
+// i.e. the decimal length prefix, a colon, the payload and a trailing comma.
+func Netstring(n int) int {
+	if n < 0 {
+		panic("strconvlen: illegal Netstring length")
+	}
+	return Int(n, 10) + 2 + n
+}
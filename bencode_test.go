@@ -0,0 +1,39 @@
+package strconvlen
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestBencodeInt(t *testing.T) {
+	for j := 1; j <= 19; j++ {
+		v := int64(randIntWithPlaces(j, 0, 0))
+		for _, n := range []int64{v, -v} {
+			vstr := "i" + strconv.FormatInt(n, 10) + "e"
+			if l := BencodeInt(n); l != len(vstr) {
+				t.Errorf("expect BencodeInt(%d) == len(%q) == %d but got %d", n, vstr, len(vstr), l)
+			}
+		}
+	}
+}
+
+func TestBencodeString(t *testing.T) {
+	for _, n := range []int{0, 1, 9, 10, 99, 100, 12345} {
+		s := strings.Repeat("x", n)
+		vstr := strconv.Itoa(n) + ":" + s
+		if l := BencodeString(n); l != len(vstr) {
+			t.Errorf("expect BencodeString(%d) == %d but got %d", n, len(vstr), l)
+		}
+	}
+}
+
+func TestNetstring(t *testing.T) {
+	for _, n := range []int{0, 1, 9, 10, 99, 100, 12345} {
+		s := strings.Repeat("x", n)
+		vstr := strconv.Itoa(n) + ":" + s + ","
+		if l := Netstring(n); l != len(vstr) {
+			t.Errorf("expect Netstring(%d) == %d but got %d", n, len(vstr), l)
+		}
+	}
+}
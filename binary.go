@@ -0,0 +1,12 @@
+package strconvlen
+
+// Uvarint returns the same result as binary.PutUvarint(buf, n).
+func Uvarint(n uint64) int {
+	// encoding/binary and protobuf share the same varint encoding.
+	return Varint(n)
+}
+
+// SignedVarint returns the same result as binary.PutVarint(buf, n).
+func SignedVarint(n int64) int {
+	return ZigZag64(n)
+}
@@ -0,0 +1,52 @@
+package strconvlen
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+func TestUvarint(t *testing.T) {
+	var buf [binary.MaxVarintLen64]byte
+
+	vlen := Uvarint(math.MaxUint64)
+	if want := binary.PutUvarint(buf[:], math.MaxUint64); vlen != want {
+		t.Errorf("expect Uvarint(v: %d) == %d but got %d", uint64(math.MaxUint64), want, vlen)
+	}
+
+	// feed in numbers around every 7 bit boundary
+	for i := 0; i <= 64; i++ {
+		for _, v := range randUint64WithBits(i) {
+			for _, vv := range []uint64{v - 1, v, v + 1} {
+				vlen := Uvarint(vv)
+				if want := binary.PutUvarint(buf[:], vv); vlen != want {
+					t.Errorf("expect Uvarint(v: %d) == %d but got %d", vv, want, vlen)
+				}
+			}
+		}
+	}
+}
+
+func TestSignedVarint(t *testing.T) {
+	var buf [binary.MaxVarintLen64]byte
+
+	for _, v := range []int64{math.MinInt64, math.MaxInt64} {
+		vlen := SignedVarint(v)
+		if want := binary.PutVarint(buf[:], v); vlen != want {
+			t.Errorf("expect SignedVarint(v: %d) == %d but got %d", v, want, vlen)
+		}
+	}
+
+	for i := 0; i <= 63; i++ {
+		for _, v := range randUint64WithBits(i) {
+			for _, vv := range []int64{int64(v) - 1, int64(v), int64(v) + 1} {
+				for _, n := range []int64{vv, -vv} {
+					vlen := SignedVarint(n)
+					if want := binary.PutVarint(buf[:], n); vlen != want {
+						t.Errorf("expect SignedVarint(v: %d) == %d but got %d", n, want, vlen)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package strconvlen
+
+import (
+	"math"
+)
+
+// CBORHeader returns the length of a CBOR data item header with the given
+// major type and argument, where the argument is the value of an integer or
+// the length of a string, array or map.
+func CBORHeader(majorType int, n uint64) int {
+	if majorType < 0 || majorType > 7 {
+		panic("strconvlen: illegal CBORHeader major type")
+	}
+
+	if n < 1<<16 {
+		if n < 1<<8 {
+			if n < 24 {
+				return 1 // argument in the initial byte
+			}
+			return 2
+		}
+		return 3
+	}
+	if n < 1<<32 {
+		return 5
+	}
+	return 9
+}
+
+// CBORUint returns the length of n after it is encoded as a CBOR unsigned
+// integer.
+func CBORUint(n uint64) int {
+	return CBORHeader(0, n)
+}
+
+// CBORInt returns the length of n after it is encoded as a CBOR integer.
+func CBORInt(n int64) int {
+	if n < 0 {
+		// Negative integers are encoded as -1-n.
+		return CBORHeader(1, uint64(-1-n))
+	}
+	return CBORHeader(0, uint64(n))
+}
+
+// CBORFloat returns the length of f after it is encoded as a CBOR float with
+// preferred serialization, which is the shortest of half, single and double
+// precision that preserves the value of f. NaN and infinities are encoded in
+// half precision.
+func CBORFloat(f float64) int {
+	if math.IsNaN(f) || math.IsInf(f, 0) || f == 0 {
+		return 3
+	}
+
+	f32 := float32(f)
+	if float64(f32) != f {
+		return 9
+	}
+
+	b := math.Float32bits(f32)
+	exp := int(b>>23&0xff) - 127
+	mant := b&0x7fffff | 1<<23 // with the implicit leading bit
+
+	// Half precision has 10 explicit mantissa bits against 23 in single
+	// precision, and its subnormals lose one more bit for each step below the
+	// smallest normal exponent.
+	switch {
+	case exp > 15 || exp < -24:
+		return 5
+	case exp >= -14:
+		if mant&(1<<13-1) == 0 {
+			return 3
+		}
+	default:
+		if mant&(1<<(13-14-exp)-1) == 0 {
+			return 3
+		}
+	}
+	return 5
+}
+
+// CBORString returns the length of a CBOR text or byte string, including its
+// header, where the string is n bytes long.
+func CBORString(n int) int {
+	if n < 0 {
+		panic("strconvlen: illegal CBORString length")
+	}
+	return CBORHeader(3, uint64(n)) + n
+}
@@ -0,0 +1,95 @@
+package strconvlen
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCBORUint(t *testing.T) {
+	samples := []struct {
+		v    uint64
+		want int
+	}{
+		{0, 1}, {23, 1},
+		{24, 2}, {255, 2},
+		{256, 3}, {math.MaxUint16, 3},
+		{math.MaxUint16 + 1, 5}, {math.MaxUint32, 5},
+		{math.MaxUint32 + 1, 9}, {math.MaxUint64, 9},
+	}
+	for _, s := range samples {
+		if l := CBORUint(s.v); l != s.want {
+			t.Errorf("expect CBORUint(%d) == %d but got %d", s.v, s.want, l)
+		}
+	}
+}
+
+func TestCBORInt(t *testing.T) {
+	samples := []struct {
+		v    int64
+		want int
+	}{
+		{0, 1}, {23, 1}, {-1, 1}, {-24, 1},
+		{24, 2}, {255, 2}, {-25, 2}, {-256, 2},
+		{256, 3}, {-257, 3}, {-65536, 3},
+		{-65537, 5}, {-4294967296, 5},
+		{-4294967297, 9}, {math.MaxInt64, 9}, {math.MinInt64, 9},
+	}
+	for _, s := range samples {
+		if l := CBORInt(s.v); l != s.want {
+			t.Errorf("expect CBORInt(%d) == %d but got %d", s.v, s.want, l)
+		}
+	}
+}
+
+func TestCBORFloat(t *testing.T) {
+	// examples from RFC 8949 appendix A
+	samples := []struct {
+		v    float64
+		want int
+	}{
+		{0.0, 3},
+		{math.Copysign(0, -1), 3},
+		{1.0, 3},
+		{1.1, 9},
+		{1.5, 3},
+		{65504.0, 3},
+		{100000.0, 5},
+		{3.4028234663852886e+38, 5},
+		{1.0e+300, 9},
+		{5.960464477539063e-8, 3},
+		{0.00006103515625, 3},
+		{-4.0, 3},
+		{-4.1, 9},
+		{math.Inf(1), 3},
+		{math.Inf(-1), 3},
+		{math.NaN(), 3},
+		// not part of the RFC
+		{65505.0, 5},
+		{math.Ldexp(1, -25), 5},
+		{math.Ldexp(3, -24), 3},
+		{math.Ldexp(3, -25), 5},
+		{math.Ldexp(1023, -24), 3},
+		{math.SmallestNonzeroFloat32, 5},
+	}
+	for _, s := range samples {
+		if l := CBORFloat(s.v); l != s.want {
+			t.Errorf("expect CBORFloat(%g) == %d but got %d", s.v, s.want, l)
+		}
+	}
+}
+
+func TestCBORString(t *testing.T) {
+	samples := []struct {
+		n, want int
+	}{
+		{0, 1}, {23, 24},
+		{24, 26}, {255, 257},
+		{256, 259}, {math.MaxUint16, math.MaxUint16 + 3},
+		{math.MaxUint16 + 1, math.MaxUint16 + 6},
+	}
+	for _, s := range samples {
+		if l := CBORString(s.n); l != s.want {
+			t.Errorf("expect CBORString(%d) == %d but got %d", s.n, s.want, l)
+		}
+	}
+}
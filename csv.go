@@ -0,0 +1,78 @@
+package strconvlen
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CSVRecord returns the number of bytes written by csv.Writer.Write when
+// given fields, with the Writer's Comma and UseCRLF set to comma and useCRLF.
+func CSVRecord(fields []string, comma rune, useCRLF bool) int {
+	if comma == 0 || comma == '"' || comma == '\r' || comma == '\n' ||
+		!utf8.ValidRune(comma) || comma == utf8.RuneError {
+		panic("strconvlen: illegal CSVRecord delimiter")
+	}
+
+	n := 0
+	if len(fields) > 0 {
+		n = (len(fields) - 1) * utf8.RuneLen(comma)
+	}
+	for _, field := range fields {
+		n += csvFieldLen(field, comma, useCRLF)
+	}
+
+	if useCRLF {
+		return n + 2
+	}
+	return n + 1
+}
+
+// csvFieldLen returns the length of field after it is quoted, if needed, by
+// csv.Writer. Inside quotes, double quotes are doubled, and with useCRLF,
+// newlines are written as CRLF while carriage returns are dropped.
+func csvFieldLen(field string, comma rune, useCRLF bool) int {
+	if !csvFieldNeedsQuotes(field, comma) {
+		return len(field)
+	}
+
+	n := len(field) + 2
+	for i := 0; i < len(field); i++ {
+		switch field[i] {
+		case '"':
+			n++
+		case '\r':
+			if useCRLF {
+				n--
+			}
+		case '\n':
+			if useCRLF {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func csvFieldNeedsQuotes(field string, comma rune) bool {
+	if field == "" {
+		return false
+	}
+	if field == `\.` {
+		return true
+	}
+
+	if comma < utf8.RuneSelf {
+		for i := 0; i < len(field); i++ {
+			c := field[i]
+			if c == '\n' || c == '\r' || c == '"' || c == byte(comma) {
+				return true
+			}
+		}
+	} else if strings.ContainsRune(field, comma) || strings.ContainsAny(field, "\"\r\n") {
+		return true
+	}
+
+	r1, _ := utf8.DecodeRuneInString(field)
+	return unicode.IsSpace(r1)
+}
@@ -0,0 +1,43 @@
+package strconvlen
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+)
+
+func TestCSVRecord(t *testing.T) {
+	samples := [][]string{
+		nil,
+		{""},
+		{"", ""},
+		{"a", "b", "c"},
+		{" leading space", "trailing space ", "\u00a0nbsp"},
+		{`\.`, `\..`, `.\`},
+		{"comma,inside", "semi;colon", "tab\tinside", "pipe|inside", "§section"},
+		{`say "hi"`, `""`, "\"quoted\""},
+		{"line\nbreak", "carriage\rreturn", "crlf\r\nline", "\r\n"},
+		{"日本語", "bad\xff"},
+	}
+	samples = append(samples, randEscapeSamples(50))
+
+	for _, comma := range []rune{',', ';', '\t', '|', '§'} {
+		for _, useCRLF := range []bool{false, true} {
+			for _, fields := range samples {
+				var buf bytes.Buffer
+				w := csv.NewWriter(&buf)
+				w.Comma = comma
+				w.UseCRLF = useCRLF
+				if err := w.Write(fields); err != nil {
+					t.Fatal(err)
+				}
+				w.Flush()
+
+				if l := CSVRecord(fields, comma, useCRLF); l != buf.Len() {
+					t.Errorf("expect CSVRecord(%q, %q, %v) == len(%q) == %d but got %d",
+						fields, comma, useCRLF, buf.String(), buf.Len(), l)
+				}
+			}
+		}
+	}
+}
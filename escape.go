@@ -0,0 +1,69 @@
+package strconvlen
+
+import (
+	"unicode/utf8"
+)
+
+// HTMLEscape returns the same result as len(html.EscapeString(s)).
+func HTMLEscape(s string) int {
+	n := len(s)
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '<', '>':
+			n += 3 // &lt; &gt;
+		case '&', '\'', '"':
+			n += 4 // &amp; &#39; &#34;
+		}
+	}
+	return n
+}
+
+// TemplateHTMLEscape returns the same result as
+// len(template.HTMLEscapeString(s)).
+func TemplateHTMLEscape(s string) int {
+	n := len(s)
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\000':
+			n += 2 // �
+		case '<', '>':
+			n += 3 // &lt; &gt;
+		case '&', '\'', '"':
+			n += 4 // &amp; &#39; &#34;
+		}
+	}
+	return n
+}
+
+// XMLEscape returns the number of bytes written by xml.EscapeText when given
+// s. Invalid UTF-8 and runes outside of the XML character range are replaced
+// with �, just like xml.EscapeText does.
+func XMLEscape(s string) int {
+	n := len(s)
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			i++
+			switch c {
+			case '<', '>':
+				n += 3 // &lt; &gt;
+			case '&', '\'', '"', '\t', '\n', '\r':
+				n += 4 // &amp; &#39; &#34; &#x9; &#xA; &#xD;
+			default:
+				if c < 0x20 {
+					n += 2 // �
+				}
+			}
+			continue
+		}
+
+		r, width := utf8.DecodeRuneInString(s[i:])
+		i += width
+		if r == utf8.RuneError && width == 1 {
+			n += 2 // �
+		}
+		// All other multibyte runes are in the XML character range except for
+		// U+FFFE and U+FFFF, which are the same width as their replacement.
+	}
+	return n
+}
@@ -0,0 +1,68 @@
+package strconvlen
+
+import (
+	"bytes"
+	"encoding/xml"
+	"html"
+	"math/rand"
+	"testing"
+	"text/template"
+)
+
+var escapeSamples = []string{
+	"",
+	"hello world",
+	`<a href="x">Tom & Jerry's</a>`,
+	"\x00\x01\x1f\x7f",
+	"tab\there\nnewline\rcarriage",
+	"日本語",
+	"�￾￿",
+	"\xff\xfe invalid \xc3",
+	"\xed\xa0\x80", // surrogate half
+	"\U0010FFFF",
+}
+
+func TestHTMLEscape(t *testing.T) {
+	for _, s := range append(escapeSamples, randEscapeSamples(100)...) {
+		want := len(html.EscapeString(s))
+		if l := HTMLEscape(s); l != want {
+			t.Errorf("expect HTMLEscape(%q) == %d but got %d", s, want, l)
+		}
+	}
+}
+
+func TestTemplateHTMLEscape(t *testing.T) {
+	for _, s := range append(escapeSamples, randEscapeSamples(100)...) {
+		want := len(template.HTMLEscapeString(s))
+		if l := TemplateHTMLEscape(s); l != want {
+			t.Errorf("expect TemplateHTMLEscape(%q) == %d but got %d", s, want, l)
+		}
+	}
+}
+
+func TestXMLEscape(t *testing.T) {
+	for _, s := range append(escapeSamples, randEscapeSamples(100)...) {
+		var buf bytes.Buffer
+		if err := xml.EscapeText(&buf, []byte(s)); err != nil {
+			t.Fatal(err)
+		}
+		want := buf.Len()
+		if l := XMLEscape(s); l != want {
+			t.Errorf("expect XMLEscape(%q) == %d but got %d", s, want, l)
+		}
+	}
+}
+
+// helpers
+
+// randEscapeSamples returns n random byte strings, which are likely to
+// contain special characters and invalid UTF-8.
+func randEscapeSamples(n int) []string {
+	ss := make([]string, n)
+	for i := range ss {
+		b := make([]byte, rand.Intn(32))
+		rand.Read(b)
+		ss[i] = string(b)
+	}
+	return ss
+}
@@ -0,0 +1,16 @@
+package strconvlen
+
+import (
+	"strconv"
+)
+
+// Float64 returns the same result as
+// len(strconv.FormatFloat(f, fmt, prec, bitSize)).
+//
+// Unlike integers, the number of digits in the shortest representation of a
+// float depends on the full conversion algorithm, so the digits are formatted
+// into a stack buffer and discarded.
+func Float64(f float64, fmt byte, prec, bitSize int) int {
+	var buf [32]byte
+	return len(strconv.AppendFloat(buf[:0], f, fmt, prec, bitSize))
+}
@@ -0,0 +1,45 @@
+package strconvlen
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestFloat64(t *testing.T) {
+	samples := []float64{
+		0, math.Copysign(0, -1), 1, -1, 0.1, 1e-7, 1e21, 123456.789,
+		math.MaxFloat64, math.SmallestNonzeroFloat64, math.MaxFloat32,
+		math.Inf(1), math.Inf(-1), math.NaN(),
+	}
+	for i := 0; i < 100; i++ {
+		samples = append(samples, randFloat64())
+	}
+
+	for _, fmt := range []byte{'b', 'e', 'E', 'f', 'g', 'G', 'x', 'X'} {
+		for _, prec := range []int{-1, 0, 3, 17} {
+			for _, bitSize := range []int{32, 64} {
+				for _, f := range samples {
+					vlen := Float64(f, fmt, prec, bitSize)
+					vstr := strconv.FormatFloat(f, fmt, prec, bitSize)
+					if len(vstr) != vlen {
+						t.Errorf("expect Float64(v: %g, fmt: %c, prec: %d, bitSize: %d) == len(%q) == %d but got %d",
+							f, fmt, prec, bitSize, vstr, len(vstr), vlen)
+					}
+				}
+			}
+		}
+	}
+}
+
+// helpers
+
+// randFloat64 returns a random float64 with a random sign and exponent.
+func randFloat64() float64 {
+	f := rand.Float64() * math.Pow10(rand.Intn(60)-30)
+	if rand.Intn(2) == 0 {
+		f = -f
+	}
+	return f
+}
@@ -0,0 +1,5 @@
+// Package httplen calculates the length of HTTP/1.1 message framing, such as
+// chunked bodies and header fields, without writing them. It is kept apart
+// from package strconvlen so that users of the number formatting functions do
+// not link net/http.
+package httplen
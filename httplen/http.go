@@ -0,0 +1,95 @@
+package httplen
+
+import (
+	"net/http"
+
+	"github.com/imacks/strconvlen"
+)
+
+// ChunkHeader returns the length of the header of a chunk of n bytes in a
+// HTTP/1.1 chunked body, i.e. the size in hex followed by CRLF.
+func ChunkHeader(n int) int {
+	if n < 0 {
+		panic("httplen: illegal ChunkHeader size")
+	}
+	return strconvlen.Uint64(uint64(n), 16) + 2
+}
+
+// ChunkedBody returns the length of a HTTP/1.1 chunked body written by
+// httputil.NewChunkedWriter, where chunkSizes are the sizes of the chunks
+// actually emitted. http.Server and http.Transport buffer writes before
+// chunking them, so the lengths of the writes to the body are not the chunk
+// sizes there. Empty chunks are skipped like the chunked writer does, and the
+// body is terminated by the last chunk, trailers and a final CRLF.
+func ChunkedBody(chunkSizes []int, trailers http.Header) int {
+	n := 0
+	for _, size := range chunkSizes {
+		if size == 0 {
+			continue
+		}
+		n += ChunkHeader(size) + size + 2
+	}
+	return n + ChunkHeader(0) + HeaderLen(trailers) + 2
+}
+
+// HeaderLen returns the number of bytes written by h.Write. Like h.Write,
+// keys are written as is, newlines in values are replaced with spaces and
+// surrounding spaces are trimmed, and keys that are not valid header field
+// names are dropped.
+func HeaderLen(h http.Header) int {
+	n := 0
+	for k, vv := range h {
+		if !isValidHeaderFieldName(k) {
+			// dropped by net/http
+			continue
+		}
+		for _, v := range vv {
+			n += len(k) + 2 + trimmedHeaderValueLen(v) + 2 // k: v\r\n
+		}
+	}
+	return n
+}
+
+// ContentLengthHeader returns the length of the "Content-Length: <n>\r\n"
+// header line, which net/http adds when the body size is known.
+func ContentLengthHeader(n int64) int {
+	if n < 0 {
+		panic("httplen: illegal ContentLengthHeader length")
+	}
+	return len("Content-Length: ") + strconvlen.Int64(n, 10) + 2
+}
+
+// trimmedHeaderValueLen returns the length of v after newlines are replaced
+// with spaces and leading and trailing ASCII spaces are trimmed.
+func trimmedHeaderValueLen(v string) int {
+	i, j := 0, len(v)
+	for i < j && isASCIISpace(v[i]) {
+		i++
+	}
+	for j > i && isASCIISpace(v[j-1]) {
+		j--
+	}
+	return j - i
+}
+
+func isASCIISpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r'
+}
+
+// isValidHeaderFieldName reports whether s is a non-empty RFC 7230 token.
+func isValidHeaderFieldName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		case c == '!', c == '#', c == '$', c == '%', c == '&', c == '\'', c == '*',
+			c == '+', c == '-', c == '.', c == '^', c == '_', c == '`', c == '|', c == '~':
+		default:
+			return false
+		}
+	}
+	return true
+}
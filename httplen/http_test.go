@@ -0,0 +1,110 @@
+package httplen
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/http/httputil"
+	"strconv"
+	"testing"
+)
+
+func TestChunkHeader(t *testing.T) {
+	for i := 0; i <= 62; i++ {
+		for _, v := range randInt64WithBits(i) {
+			vstr := fmt.Sprintf("%x\r\n", v)
+			if l := ChunkHeader(int(v)); l != len(vstr) {
+				t.Errorf("expect ChunkHeader(%d) == len(%q) == %d but got %d", v, vstr, len(vstr), l)
+			}
+		}
+	}
+}
+
+func TestChunkedBody(t *testing.T) {
+	samples := []struct {
+		chunkSizes []int
+		trailers   http.Header
+	}{
+		{nil, nil},
+		{[]int{1, 0, 15, 16, 255, 4096, 70000}, nil},
+		{[]int{10}, http.Header{
+			"X-Checksum": {"abc123"},
+			"X-Multi":    {"  one ", "two\r\nthree", "\t"},
+			"Bad Name":   {"dropped"},
+			"":           {"dropped"},
+		}},
+	}
+
+	for _, s := range samples {
+		var buf bytes.Buffer
+		cw := httputil.NewChunkedWriter(&buf)
+		for _, size := range s.chunkSizes {
+			cw.Write(bytes.Repeat([]byte{'x'}, size))
+		}
+		cw.Close()
+		s.trailers.Write(&buf)
+		buf.WriteString("\r\n")
+
+		if l := ChunkedBody(s.chunkSizes, s.trailers); l != buf.Len() {
+			t.Errorf("expect ChunkedBody(%v, %v) == len(%q) == %d but got %d",
+				s.chunkSizes, s.trailers, buf.String(), buf.Len(), l)
+		}
+	}
+}
+
+func TestHeaderLen(t *testing.T) {
+	samples := []http.Header{
+		nil,
+		{},
+		{"Content-Type": {"text/plain; charset=utf-8"}},
+		{
+			"content-type": {"not canonicalized"},
+			"X-Multi":      {"  one ", "two\r\nthree", "\t", ""},
+			"Set-Cookie":   {"a=1", "b=2"},
+			"Bad Name":     {"dropped"},
+			"Bad:Name":     {"dropped"},
+			"":             {"dropped"},
+			"X-Empty":      {},
+		},
+	}
+
+	for _, h := range samples {
+		var buf bytes.Buffer
+		h.Write(&buf)
+		if l := HeaderLen(h); l != buf.Len() {
+			t.Errorf("expect HeaderLen(%v) == len(%q) == %d but got %d", h, buf.String(), buf.Len(), l)
+		}
+	}
+}
+
+func TestContentLengthHeader(t *testing.T) {
+	for i := 0; i <= 63; i++ {
+		v := randInt64WithBits(i)[2]
+		h := http.Header{}
+		h.Set("Content-Length", strconv.FormatInt(v, 10))
+
+		var buf bytes.Buffer
+		h.Write(&buf)
+		if l := ContentLengthHeader(v); l != buf.Len() {
+			t.Errorf("expect ContentLengthHeader(%d) == len(%q) == %d but got %d", v, buf.String(), buf.Len(), l)
+		}
+	}
+}
+
+// helpers
+
+// randInt64WithBits returns the smallest and largest non-negative integers
+// with the specified bit length, and a random one in between.
+func randInt64WithBits(bits int) []int64 {
+	if bits < 0 || bits > 63 {
+		panic("bits must be between 0-63")
+	}
+	if bits == 0 {
+		return []int64{0, 0, 0}
+	}
+	lo := int64(1) << (bits - 1)
+	hi := int64(math.MaxInt64) >> (63 - bits)
+	return []int64{lo, hi, lo + rand.Int63n(hi-lo+1)}
+}
@@ -0,0 +1,106 @@
+package strconvlen
+
+import (
+	"math"
+)
+
+// KV is a key and value pair of an InfluxDB line protocol tag or field. Tag
+// values are strings, while field values may be strings, booleans, integers
+// or floats.
+type KV struct {
+	Key   string
+	Value interface{}
+}
+
+// InfluxLine returns the length of a point in the InfluxDB line protocol,
+// i.e. "measurement,tag=value field=value timestamp\n". The tags are omitted
+// if there are none, and the timestamp is omitted if ts is nil. Floats are
+// formatted by strconv.FormatFloat(f, 'f', -1, 64), signed integers have the
+// "i" suffix and unsigned integers have the "u" suffix. It panics on NaN and
+// infinite floats, which line protocol cannot represent.
+func InfluxLine(measurement string, tags, fields []KV, ts *int64) int {
+	n := influxEscapedLen(measurement, false)
+	for _, tag := range tags {
+		v, ok := tag.Value.(string)
+		if !ok {
+			panic("strconvlen: illegal InfluxLine tag value type")
+		}
+		n += 1 + influxEscapedLen(tag.Key, true) + 1 + influxEscapedLen(v, true) // ,key=value
+	}
+
+	n += len(fields) // space and commas
+	for _, field := range fields {
+		n += influxEscapedLen(field.Key, true) + 1 + influxFieldValueLen(field.Value) // key=value
+	}
+
+	if ts != nil {
+		n += 1 + Int64(*ts, 10)
+	}
+	return n + 1 // newline
+}
+
+// influxEscapedLen returns the length of s after commas and spaces are
+// escaped, plus equal signs if s is a tag key, tag value or field key.
+func influxEscapedLen(s string, escapeEquals bool) int {
+	n := len(s)
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case ',', ' ':
+			n++
+		case '=':
+			if escapeEquals {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func influxFieldValueLen(v interface{}) int {
+	switch v := v.(type) {
+	case float64:
+		return influxFloatLen(v, 64)
+	case float32:
+		return influxFloatLen(float64(v), 32)
+	case int:
+		return Int(v, 10) + 1
+	case int8:
+		return Int8(v, 10) + 1
+	case int16:
+		return Int16(v, 10) + 1
+	case int32:
+		return Int32(v, 10) + 1
+	case int64:
+		return Int64(v, 10) + 1
+	case uint:
+		return Uint(v, 10) + 1
+	case uint8:
+		return Uint8(v, 10) + 1
+	case uint16:
+		return Uint16(v, 10) + 1
+	case uint32:
+		return Uint32(v, 10) + 1
+	case uint64:
+		return Uint64(v, 10) + 1
+	case bool:
+		return Bool(v)
+	case string:
+		// quoted, with double quotes and backslashes escaped
+		n := len(v) + 2
+		for i := 0; i < len(v); i++ {
+			if v[i] == '"' || v[i] == '\\' {
+				n++
+			}
+		}
+		return n
+	default:
+		panic("strconvlen: illegal InfluxLine field value type")
+	}
+}
+
+func influxFloatLen(f float64, bitSize int) int {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		panic("strconvlen: illegal InfluxLine field value")
+	}
+	return Float64(f, 'f', -1, bitSize)
+}
@@ -0,0 +1,49 @@
+package strconvlen
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInfluxLine(t *testing.T) {
+	samples := []struct {
+		measurement  string
+		tags, fields []KV
+		ts           *int64
+		want         string
+	}{
+		{"cpu", nil, []KV{{"value", 0.64}}, nil, "cpu value=0.64\n"},
+		{
+			"cpu",
+			[]KV{{"host", "server01"}, {"region", "us-west"}},
+			[]KV{{"idle", 1e-7}, {"count", int64(-42)}, {"total", uint64(math.MaxUint64)}, {"ok", true}},
+			int64Ptr(1434055562000000000),
+			"cpu,host=server01,region=us-west idle=0.0000001,count=-42i,total=18446744073709551615u,ok=true 1434055562000000000\n",
+		},
+		{
+			"my measurement,x=y",
+			[]KV{{"tag key", "tag,value=1"}},
+			[]KV{{"field=key", `say "hi" \o/`}, {"n", 7}, {"f", float32(1.5)}, {"b", uint8(255)}},
+			int64Ptr(-1),
+			`my\ measurement\,x=y,tag\ key=tag\,value\=1 field\=key="say \"hi\" \\o/",n=7i,f=1.5,b=255u -1` + "\n",
+		},
+		{"epoch", nil, []KV{{"n", uint(1)}}, int64Ptr(0), "epoch n=1u 0\n"},
+	}
+
+	for _, s := range samples {
+		if l := InfluxLine(s.measurement, s.tags, s.fields, s.ts); l != len(s.want) {
+			t.Errorf("expect InfluxLine() == len(%q) == %d but got %d", s.want, len(s.want), l)
+		}
+	}
+
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expect InfluxLine(f: %v) to panic", f)
+				}
+			}()
+			InfluxLine("m", nil, []KV{{"f", f}}, nil)
+		}()
+	}
+}
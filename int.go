@@ -578,43 +578,8 @@ func Uint32(n uint32, base int) int {
 		}
 		return 21
 	default:
-		bbase := uint32(base)
-
-		if n < bbase {
-			return 1
-		}
-
-		var maxpow int
-		if base >= 24 {
-			maxpow = 6
-		} else if base >= 17 {
-			maxpow = 7
-		} else if base >= 12 {
-			maxpow = 8
-		} else {
-			switch base {
-			case 5:
-				maxpow = 13
-			case 6:
-				maxpow = 12
-			case 7:
-				maxpow = 11
-			case 9:
-				maxpow = 10
-			case 11:
-				maxpow = 9
-			}
-		}
-
-		mbase := uint32(base)
-		for i := 2; i < (maxpow + 1); i++ {
-			mbase *= bbase
-			if n < mbase {
-				return i
-			}
-		}
-
-		return maxpow + 1
+		// all other bases are looked up in the power tables
+		return powLen(uint64(n), base)
 	}
 }
 
@@ -919,51 +884,8 @@ func Uint64(n uint64, base int) int {
 		}
 		return 13
 	default:
-		bbase := uint64(base)
-
-		if n < bbase {
-			return 1
-		}
-
-		var maxpow int
-		if base >= 31 {
-			maxpow = 12
-		} else if base >= 24 {
-			maxpow = 13
-		} else if base >= 20 {
-			maxpow = 14
-		} else if base >= 16 {
-			maxpow = 15
-		} else if base >= 14 {
-			maxpow = 16
-		} else if base >= 12 {
-			maxpow = 17
-		} else {
-			switch base {
-			case 3:
-				maxpow = 40
-			case 5:
-				maxpow = 27
-			case 6:
-				maxpow = 24
-			case 7:
-				maxpow = 22
-			case 9:
-				maxpow = 20
-			case 11:
-				maxpow = 18
-			}
-		}
-
-		mbase := uint64(base)
-		for i := 2; i < (maxpow + 1); i++ {
-			mbase *= bbase
-			if n < mbase {
-				return i
-			}
-		}
-
-		return maxpow + 1
+		// all other bases are looked up in the power tables
+		return powLen(uint64(n), base)
 	}
 }
 
@@ -211,3 +211,43 @@ func getBaseRange() []int {
 	}
 	return bb
 }
+
+func BenchmarkUintN(b *testing.B) {
+	const places = 20
+
+	if envv, ok := os.LookupEnv("INT_BENCH"); ok {
+		if envv != "uintn" {
+			b.Skipf("skip benchmark with env INT_BENCH=%s", envv)
+		}
+	}
+
+	samples := make([]uint64, places)
+	for i := 0; i < len(samples); i++ {
+		samples[i] = randUint64WithPlaces(i + 1)
+	}
+
+	for _, i := range getPowBaseRange() {
+		for j, v := range samples {
+			b.Run(fmt.Sprintf("base%d_%dp", i, j+1), func(b2 *testing.B) {
+				b2.ReportAllocs()
+				for k := 0; k < b2.N; k++ {
+					UintN(v, i)
+				}
+			})
+		}
+	}
+}
+
+// getPowBaseRange returns the bases that are looked up in the power tables,
+// i.e. all bases up to 62 that Uint64 does not special case.
+func getPowBaseRange() []int {
+	var bb []int
+	for i := 3; i <= 62; i++ {
+		switch i {
+		case 4, 8, 10, 16, 32:
+			continue
+		}
+		bb = append(bb, i)
+	}
+	return bb
+}
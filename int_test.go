@@ -145,8 +145,47 @@ func TestInt64(t *testing.T) {
 	}
 }
 
+func TestUint64Pow(t *testing.T) {
+	for i := 2; i <= 36; i++ {
+		for _, v := range powBoundaries(i) {
+			vlen := Uint64(v, i)
+			vstr := strconv.FormatUint(v, i)
+			if len(vstr) != vlen {
+				t.Errorf("expect Uint64(v: %d, base: %d) == len(%q) == %d but got %d",
+					v, i, vstr, len(vstr), vlen)
+			}
+
+			if v > math.MaxUint32 {
+				continue
+			}
+			vlen = Uint32(uint32(v), i)
+			if len(vstr) != vlen {
+				t.Errorf("expect Uint32(v: %d, base: %d) == len(%q) == %d but got %d",
+					v, i, vstr, len(vstr), vlen)
+			}
+		}
+	}
+}
+
 // helpers
 
+// powBoundaries returns every power of base that fits into a uint64, the
+// numbers right before them, and every power of 2 and the numbers right
+// before them.
+func powBoundaries(base int) []uint64 {
+	vv := []uint64{0, math.MaxUint64}
+	for p := uint64(base); ; p *= uint64(base) {
+		vv = append(vv, p-1, p)
+		if p > math.MaxUint64/uint64(base) {
+			break
+		}
+	}
+	for i := 0; i < 64; i++ {
+		vv = append(vv, 1<<i, 1<<i-1)
+	}
+	return vv
+}
+
 // randUint64WithPlaces returns a random positive unsigned integer with the
 // specified number of places, where places must be between 1-20.
 func randUint64WithPlaces(places int) uint64 {
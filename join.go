@@ -0,0 +1,38 @@
+package strconvlen
+
+// JoinInts returns the length of the numbers in ns formatted in base and
+// joined by sep, i.e. the length of strings.Join of every
+// strconv.FormatInt(n, base) in ns.
+func JoinInts(ns []int64, base int, sep string) int {
+	if base < 2 || base > 36 {
+		panic("strconvlen: illegal JoinInts base")
+	}
+	if len(ns) == 0 {
+		return 0
+	}
+	return SumInt64(ns, base) + len(sep)*(len(ns)-1)
+}
+
+// JoinStrings returns the same result as len(strings.Join(ss, sep)).
+func JoinStrings(ss []string, sep string) int {
+	if len(ss) == 0 {
+		return 0
+	}
+	n := len(sep) * (len(ss) - 1)
+	for _, s := range ss {
+		n += len(s)
+	}
+	return n
+}
+
+// BracketedInts returns the length of the numbers in ns formatted in base,
+// separated by spaces and enclosed in square brackets. In base 10, it returns
+// the same result as len(fmt.Sprint(ns)).
+func BracketedInts(ns []int64, base int) int {
+	return JoinInts(ns, base, " ") + 2
+}
+
+// BracketedStrings returns the same result as len(fmt.Sprint(ss)).
+func BracketedStrings(ss []string) int {
+	return JoinStrings(ss, " ") + 2
+}
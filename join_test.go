@@ -0,0 +1,92 @@
+package strconvlen
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestJoinInts(t *testing.T) {
+	samples := [][]int64{
+		nil,
+		{},
+		{0},
+		{1, 2, 3},
+		{-1, math.MinInt64, math.MaxInt64, 0},
+	}
+	for j := 1; j <= 19; j++ {
+		v := int64(randIntWithPlaces(j, 0, 0))
+		samples = append(samples, []int64{v, -v, v})
+	}
+
+	for i := 2; i <= 36; i++ {
+		for _, ns := range samples {
+			ss := make([]string, len(ns))
+			for k, n := range ns {
+				ss[k] = strconv.FormatInt(n, i)
+			}
+
+			for _, sep := range []string{"", ",", ", "} {
+				vstr := strings.Join(ss, sep)
+				if vlen := JoinInts(ns, i, sep); len(vstr) != vlen {
+					t.Errorf("expect JoinInts(ns: %v, base: %d, sep: %q) == len(%q) == %d but got %d",
+						ns, i, sep, vstr, len(vstr), vlen)
+				}
+			}
+
+			vstr := "[" + strings.Join(ss, " ") + "]"
+			if i == 10 {
+				vstr = fmt.Sprint(ns)
+			}
+			if vlen := BracketedInts(ns, i); len(vstr) != vlen {
+				t.Errorf("expect BracketedInts(ns: %v, base: %d) == len(%q) == %d but got %d",
+					ns, i, vstr, len(vstr), vlen)
+			}
+		}
+	}
+}
+
+func TestJoinIntsBase(t *testing.T) {
+	for _, base := range []int{-1, 0, 1, 37, 99} {
+		for _, ns := range [][]int64{nil, {1}} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("expect JoinInts(ns: %v, base: %d) to panic", ns, base)
+					}
+				}()
+				JoinInts(ns, base, ",")
+			}()
+		}
+	}
+}
+
+func TestJoinStrings(t *testing.T) {
+	samples := [][]string{
+		nil,
+		{},
+		{""},
+		{"", ""},
+		{"a", "b", "c"},
+		{"hello", "", "wörld"},
+		escapeSamples,
+	}
+
+	for _, ss := range samples {
+		for _, sep := range []string{"", ",", " | "} {
+			vstr := strings.Join(ss, sep)
+			if vlen := JoinStrings(ss, sep); len(vstr) != vlen {
+				t.Errorf("expect JoinStrings(ss: %q, sep: %q) == len(%q) == %d but got %d",
+					ss, sep, vstr, len(vstr), vlen)
+			}
+		}
+
+		vstr := fmt.Sprint(ss)
+		if vlen := BracketedStrings(ss); len(vstr) != vlen {
+			t.Errorf("expect BracketedStrings(ss: %q) == len(%q) == %d but got %d",
+				ss, vstr, len(vstr), vlen)
+		}
+	}
+}
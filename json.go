@@ -0,0 +1,128 @@
+package strconvlen
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+	"unicode/utf8"
+)
+
+// JSONNull is the length of the JSON null literal.
+const JSONNull = 4
+
+// JSONBool returns the length of b after it is encoded as JSON by
+// encoding/json.
+func JSONBool(b bool) int {
+	return Bool(b)
+}
+
+// JSONInt64 returns the length of n after it is encoded as JSON by
+// encoding/json.
+func JSONInt64(n int64) int {
+	return Int64(n, 10)
+}
+
+// JSONUint64 returns the length of n after it is encoded as JSON by
+// encoding/json.
+func JSONUint64(n uint64) int {
+	return Uint64(n, 10)
+}
+
+// JSONFloat64 returns the length of f after it is encoded as JSON by
+// encoding/json, where bits is 32 for float32 and 64 for float64 values. Like
+// encoding/json, an error is returned if f is NaN or infinity.
+func JSONFloat64(f float64, bits int) (int, error) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		var v reflect.Value
+		if bits == 32 {
+			v = reflect.ValueOf(float32(f))
+		} else {
+			v = reflect.ValueOf(f)
+		}
+		return 0, &json.UnsupportedValueError{
+			Value: v,
+			Str:   strconv.FormatFloat(f, 'g', -1, bits),
+		}
+	}
+
+	// encoding/json formats floats like ES6, using the exponent format only for
+	// very small or big numbers. The exponent is not padded to 2 digits, so
+	// e-07 is shortened to e-7.
+	abs := math.Abs(f)
+	if abs == 0 ||
+		bits == 64 && abs >= 1e-6 && abs < 1e21 ||
+		bits == 32 && float32(abs) >= 1e-6 && float32(abs) < 1e21 {
+		return Float64(f, 'f', -1, bits), nil
+	}
+
+	var buf [32]byte
+	b := strconv.AppendFloat(buf[:0], f, 'e', -1, bits)
+	n := len(b)
+	if n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+		n--
+	}
+	return n, nil
+}
+
+// JSONString returns the length of s after it is encoded as a JSON string by
+// encoding/json, including the surrounding quotes. json.Marshal always
+// escapes HTML characters, while json.Encoder does so unless
+// SetEscapeHTML(false) is called. Invalid UTF-8 is coerced into
+// utf8.RuneError, which is spelled differently depending on whether
+// encoding/json is backed by encoding/json/v2. Backspace and form feed are
+// escaped as \b and \f since Go 1.22, and as \u0008 and \u000c before that.
+func JSONString(s string, escapeHTML bool) int {
+	n, _ := jsonString(s, escapeHTML)
+	return n
+}
+
+// jsonString is the same as JSONString but also reports the number of quotes
+// and backslashes between the surrounding quotes, which need escaping when the
+// encoded string is quoted again by the ",string" struct tag option.
+func jsonString(s string, escapeHTML bool) (n, esc int) {
+	n = len(s) + 2 // quotes
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			i++
+			switch c {
+			case '\\', '"':
+				n++ // \\ \"
+				esc += 2
+			case '\b', '\f':
+				if jsonShortEscapeBF {
+					n++ // \b \f
+				} else {
+					n += 5 // \u0008 \u000c
+				}
+				esc++
+			case '\n', '\r', '\t':
+				n++ // \n \r \t
+				esc++
+			case '<', '>', '&':
+				if escapeHTML {
+					n += 5 // \u003c \u003e \u0026
+					esc++
+				}
+			default:
+				if c < 0x20 {
+					n += 5 // \u00XX
+					esc++
+				}
+			}
+			continue
+		}
+
+		r, width := utf8.DecodeRuneInString(s[i:])
+		i += width
+		if r == utf8.RuneError && width == 1 {
+			n += jsonInvalidUTF8Len - 1
+			esc += jsonInvalidUTF8Esc
+		} else if r == '\u2028' || r == '\u2029' {
+			n += 3 // \u2028 \u2029
+			esc++
+		}
+	}
+	return n, esc
+}
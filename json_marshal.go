@@ -0,0 +1,631 @@
+package strconvlen
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// JSONLen returns the same result as len(json.Marshal(v)), and an error
+// equivalent to the one json.Marshal would return. Values that implement json.Marshaler are
+// marshaled by calling their MarshalJSON method, but otherwise no output is
+// produced.
+func JSONLen(v interface{}) (int, error) {
+	var s jsonLenState
+	return s.value(reflect.ValueOf(v), false)
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	jsonNumberType    = reflect.TypeOf(json.Number(""))
+	jsonIsZeroerType  = reflect.TypeOf((*interface{ IsZero() bool })(nil)).Elem()
+	jsonFieldCache    sync.Map // map[reflect.Type][]jsonField
+)
+
+// jsonStartCycleCheckAt is the nesting level at which JSONLen starts to check
+// for cycles, which matches encoding/json.
+const jsonStartCycleCheckAt = 1000
+
+// jsonLenState tracks pointers on the path to the current value, so that
+// cycles are reported the same way encoding/json does.
+type jsonLenState struct {
+	ptrLevel uint
+	ptrSeen  map[interface{}]struct{}
+}
+
+func (s *jsonLenState) value(v reflect.Value, quoted bool) (int, error) {
+	if !v.IsValid() {
+		return JSONNull, nil
+	}
+
+	t := v.Type()
+	if t.Kind() != reflect.Ptr && v.CanAddr() && reflect.PtrTo(t).Implements(jsonMarshalerType) {
+		return jsonMarshalerLen(v.Addr())
+	}
+	if t.Implements(jsonMarshalerType) {
+		return jsonMarshalerLen(v)
+	}
+	if t.Kind() != reflect.Ptr && v.CanAddr() && reflect.PtrTo(t).Implements(textMarshalerType) {
+		return jsonTextMarshalerLen(v.Addr())
+	}
+	if t.Implements(textMarshalerType) {
+		return jsonTextMarshalerLen(v)
+	}
+
+	q := 0
+	if quoted {
+		q = 2
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return JSONBool(v.Bool()) + q, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return JSONInt64(v.Int()) + q, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return JSONUint64(v.Uint()) + q, nil
+	case reflect.Float32:
+		n, err := JSONFloat64(v.Float(), 32)
+		return n + q, err
+	case reflect.Float64:
+		n, err := JSONFloat64(v.Float(), 64)
+		return n + q, err
+	case reflect.String:
+		if t == jsonNumberType {
+			num := v.String()
+			if num == "" {
+				num = "0"
+			}
+			if !isValidJSONNumber(num) {
+				return 0, fmt.Errorf("json: invalid number literal %q", num)
+			}
+			return len(num) + q, nil
+		}
+		n, esc := jsonString(v.String(), true)
+		if quoted {
+			// The encoded string, including its quotes, is encoded again.
+			n += 2 + 2 + esc
+		}
+		return n, nil
+	case reflect.Interface:
+		if v.IsNil() {
+			return JSONNull, nil
+		}
+		return s.value(v.Elem(), false)
+	case reflect.Struct:
+		return s.structLen(v)
+	case reflect.Map:
+		return s.mapLen(v)
+	case reflect.Slice:
+		return s.sliceLen(v)
+	case reflect.Array:
+		return s.arrayLen(v)
+	case reflect.Ptr:
+		if v.IsNil() {
+			return JSONNull, nil
+		}
+		if err := s.enter(v, v.Interface()); err != nil {
+			return 0, err
+		}
+		n, err := s.value(v.Elem(), quoted)
+		s.leave(v.Interface())
+		return n, err
+	default:
+		return 0, &json.UnsupportedTypeError{Type: t}
+	}
+}
+
+// enter increases the nesting level and, once it gets deep enough, checks
+// whether ptr has already been seen on the current path.
+func (s *jsonLenState) enter(v reflect.Value, ptr interface{}) error {
+	s.ptrLevel++
+	if s.ptrLevel <= jsonStartCycleCheckAt {
+		return nil
+	}
+	if s.ptrSeen == nil {
+		s.ptrSeen = make(map[interface{}]struct{})
+	}
+	if _, ok := s.ptrSeen[ptr]; ok {
+		s.ptrLevel--
+		return &json.UnsupportedValueError{
+			Value: v,
+			Str:   fmt.Sprintf("encountered a cycle via %s", v.Type()),
+		}
+	}
+	s.ptrSeen[ptr] = struct{}{}
+	return nil
+}
+
+func (s *jsonLenState) leave(ptr interface{}) {
+	if s.ptrLevel > jsonStartCycleCheckAt {
+		delete(s.ptrSeen, ptr)
+	}
+	s.ptrLevel--
+}
+
+func (s *jsonLenState) structLen(v reflect.Value) (int, error) {
+	n := 1 // {
+	count := 0
+
+FieldLoop:
+	for _, f := range jsonTypeFields(v.Type()) {
+		fv := v
+		for _, i := range f.index {
+			if fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					continue FieldLoop
+				}
+				fv = fv.Elem()
+			}
+			fv = fv.Field(i)
+		}
+
+		if (f.omitEmpty && isEmptyJSONValue(fv)) || (f.omitZero && isZeroJSONValue(fv)) {
+			continue
+		}
+
+		vn, err := s.value(fv, f.quoted)
+		if err != nil {
+			return 0, err
+		}
+		n += f.nameLen + vn
+		count++
+	}
+
+	if count == 0 {
+		return 2, nil // {}
+	}
+	return n + count, nil // commas and }
+}
+
+func (s *jsonLenState) mapLen(v reflect.Value) (int, error) {
+	if (jsonEmptyMapKeyCheck || v.Len() > 0) && !isValidJSONMapKeyType(v.Type().Key()) {
+		return 0, &json.UnsupportedTypeError{Type: v.Type()}
+	}
+	if v.IsNil() {
+		return JSONNull, nil
+	}
+
+	ptr := v.Pointer()
+	if err := s.enter(v, ptr); err != nil {
+		return 0, err
+	}
+	defer s.leave(ptr)
+
+	if v.Len() == 0 {
+		return 2, nil // {}
+	}
+
+	n := 1 + v.Len() // {, commas and }
+	iter := v.MapRange()
+	for iter.Next() {
+		kn, err := jsonMapKeyLen(iter.Key())
+		if err != nil {
+			return 0, fmt.Errorf("json: encoding error for type %q: %q", v.Type().String(), err.Error())
+		}
+		vn, err := s.value(iter.Value(), false)
+		if err != nil {
+			return 0, err
+		}
+		n += kn + 1 + vn // key:value
+	}
+	return n, nil
+}
+
+func (s *jsonLenState) sliceLen(v reflect.Value) (int, error) {
+	if v.IsNil() {
+		return JSONNull, nil
+	}
+
+	// []byte is encoded as a base64 string, unless its elements are marshalers.
+	et := v.Type().Elem()
+	if et.Kind() == reflect.Uint8 {
+		pt := reflect.PtrTo(et)
+		if !pt.Implements(jsonMarshalerType) && !pt.Implements(textMarshalerType) {
+			return 2 + base64.StdEncoding.EncodedLen(v.Len()), nil
+		}
+	}
+
+	ptr := struct {
+		ptr uintptr
+		len int
+	}{v.Pointer(), v.Len()}
+	if err := s.enter(v, ptr); err != nil {
+		return 0, err
+	}
+	defer s.leave(ptr)
+	return s.arrayLen(v)
+}
+
+func (s *jsonLenState) arrayLen(v reflect.Value) (int, error) {
+	l := v.Len()
+	if l == 0 {
+		return 2, nil // []
+	}
+
+	n := 1 + l // [, commas and ]
+	for i := 0; i < l; i++ {
+		en, err := s.value(v.Index(i), false)
+		if err != nil {
+			return 0, err
+		}
+		n += en
+	}
+	return n, nil
+}
+
+func jsonMarshalerLen(v reflect.Value) (int, error) {
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return JSONNull, nil
+	}
+	m, ok := v.Interface().(json.Marshaler)
+	if !ok {
+		return JSONNull, nil
+	}
+	b, err := m.MarshalJSON()
+	if err != nil {
+		return 0, &json.MarshalerError{Type: v.Type(), Err: err}
+	}
+
+	// encoding/json compacts the output and escapes HTML characters in it.
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, b); err != nil {
+		return 0, &json.MarshalerError{Type: v.Type(), Err: err}
+	}
+	b = buf.Bytes()
+	n := len(b)
+	for i, c := range b {
+		switch c {
+		case '<', '>', '&':
+			n += 5
+		case 0xE2:
+			// U+2028 and U+2029
+			if i+2 < len(b) && b[i+1] == 0x80 && b[i+2]&^1 == 0xA8 {
+				n += 3
+			}
+		}
+	}
+	return n, nil
+}
+
+func jsonTextMarshalerLen(v reflect.Value) (int, error) {
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return JSONNull, nil
+	}
+	m, ok := v.Interface().(encoding.TextMarshaler)
+	if !ok {
+		return JSONNull, nil
+	}
+	b, err := m.MarshalText()
+	if err != nil {
+		return 0, &jsonTextMarshalerError{&json.MarshalerError{Type: v.Type(), Err: err}}
+	}
+	return JSONString(string(b), true), nil
+}
+
+// jsonTextMarshalerError wraps the error of a failing MarshalText method. A
+// json.MarshalerError created outside encoding/json always blames MarshalJSON
+// in its message, so the message is spelled out here instead.
+type jsonTextMarshalerError struct {
+	*json.MarshalerError
+}
+
+func (e *jsonTextMarshalerError) Error() string {
+	return "json: error calling MarshalText for type " + e.Type.String() + ": " + e.Err.Error()
+}
+
+func (e *jsonTextMarshalerError) Unwrap() error {
+	return e.MarshalerError
+}
+
+func jsonMapKeyLen(k reflect.Value) (int, error) {
+	if k.Kind() == reflect.String && (!jsonTextMarshalerMapKeys || !k.Type().Implements(textMarshalerType)) {
+		return JSONString(k.String(), true), nil
+	}
+	if tm, ok := k.Interface().(encoding.TextMarshaler); ok {
+		if k.Kind() == reflect.Ptr && k.IsNil() {
+			return 2, nil // ""
+		}
+		b, err := tm.MarshalText()
+		if err != nil {
+			return 0, err
+		}
+		return JSONString(string(b), true), nil
+	}
+	switch k.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Int64(k.Int(), 10) + 2, nil
+	case reflect.Float32, reflect.Float64:
+		n, err := JSONFloat64(k.Float(), k.Type().Bits())
+		return n + 2, err
+	default:
+		return Uint64(k.Uint(), 10) + 2, nil
+	}
+}
+
+// isValidJSONMapKeyType reports whether maps with keys of type t can be
+// marshaled.
+func isValidJSONMapKeyType(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	case reflect.Float32, reflect.Float64:
+		return jsonFloatMapKeys || t.Implements(textMarshalerType)
+	}
+	return t.Implements(textMarshalerType)
+}
+
+// isValidJSONNumber reports whether s is a valid JSON number literal.
+func isValidJSONNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	// A valid JSON value that begins with a minus sign or a digit and ends
+	// with a digit can only be a number.
+	if c := s[0]; c != '-' && (c < '0' || c > '9') {
+		return false
+	}
+	if c := s[len(s)-1]; c < '0' || c > '9' {
+		return false
+	}
+	return json.Valid([]byte(s))
+}
+
+func isEmptyJSONValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
+
+// isZeroJSONValue implements the "omitzero" struct tag option of Go 1.24 and
+// later, which prefers the IsZero method of v if there is one.
+func isZeroJSONValue(v reflect.Value) bool {
+	t := v.Type()
+	switch {
+	case t.Kind() == reflect.Interface && t.Implements(jsonIsZeroerType):
+		return v.IsNil() ||
+			(v.Elem().Kind() == reflect.Ptr && v.Elem().IsNil()) ||
+			v.Interface().(interface{ IsZero() bool }).IsZero()
+	case t.Kind() == reflect.Ptr && t.Implements(jsonIsZeroerType):
+		return v.IsNil() || v.Interface().(interface{ IsZero() bool }).IsZero()
+	case t.Implements(jsonIsZeroerType):
+		return v.Interface().(interface{ IsZero() bool }).IsZero()
+	case reflect.PtrTo(t).Implements(jsonIsZeroerType):
+		if !v.CanAddr() {
+			v2 := reflect.New(t).Elem()
+			v2.Set(v)
+			v = v2
+		}
+		return v.Addr().Interface().(interface{ IsZero() bool }).IsZero()
+	}
+	return v.IsZero()
+}
+
+// jsonField is a struct field that is encoded by encoding/json.
+type jsonField struct {
+	name      string
+	nameLen   int // encoded name and colon
+	tag       bool
+	index     []int
+	omitEmpty bool
+	omitZero  bool
+	quoted    bool
+}
+
+// jsonTypeFields returns the fields of struct type t that encoding/json
+// encodes, in the order they are encoded. It follows the same rules as
+// encoding/json for embedded structs and conflicting names.
+func jsonTypeFields(t reflect.Type) []jsonField {
+	if f, ok := jsonFieldCache.Load(t); ok {
+		return f.([]jsonField)
+	}
+
+	type embedded struct {
+		typ   reflect.Type
+		index []int
+	}
+
+	// Anonymous fields to explore at the current level and the next.
+	current := []embedded{}
+	next := []embedded{{typ: t}}
+
+	// Count of queued names for current level and the next.
+	var count, nextCount map[reflect.Type]int
+
+	// Types already visited at an earlier level.
+	visited := map[reflect.Type]bool{}
+
+	var fields []jsonField
+	for len(next) > 0 {
+		current, next = next, current[:0]
+		count, nextCount = nextCount, map[reflect.Type]int{}
+
+		for _, f := range current {
+			if visited[f.typ] {
+				continue
+			}
+			visited[f.typ] = true
+
+			for i := 0; i < f.typ.NumField(); i++ {
+				sf := f.typ.Field(i)
+				exported := sf.PkgPath == ""
+				if sf.Anonymous {
+					t := sf.Type
+					if t.Kind() == reflect.Ptr {
+						t = t.Elem()
+					}
+					if !exported && t.Kind() != reflect.Struct {
+						continue
+					}
+				} else if !exported {
+					continue
+				}
+
+				tag := sf.Tag.Get("json")
+				if tag == "-" {
+					continue
+				}
+				name, opts := tag, ""
+				if i := strings.IndexByte(tag, ','); i >= 0 {
+					name, opts = tag[:i], tag[i+1:]
+				}
+				if !isValidJSONTag(name) {
+					name = ""
+				}
+				index := make([]int, len(f.index)+1)
+				copy(index, f.index)
+				index[len(f.index)] = i
+
+				ft := sf.Type
+				if ft.Name() == "" && ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+
+				// Only strings, floats, integers, and booleans can be quoted.
+				quoted := false
+				if hasJSONTagOption(opts, "string") {
+					switch ft.Kind() {
+					case reflect.Bool,
+						reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+						reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+						reflect.Float32, reflect.Float64,
+						reflect.String:
+						quoted = true
+					}
+				}
+
+				if name != "" || !sf.Anonymous || ft.Kind() != reflect.Struct {
+					tagged := name != ""
+					if name == "" {
+						name = sf.Name
+					}
+					fields = append(fields, jsonField{
+						name:      name,
+						nameLen:   JSONString(name, true) + 1,
+						tag:       tagged,
+						index:     index,
+						omitEmpty: hasJSONTagOption(opts, "omitempty"),
+						omitZero:  jsonOmitZero && hasJSONTagOption(opts, "omitzero"),
+						quoted:    quoted,
+					})
+					if count[f.typ] > 1 {
+						// Add a duplicate so that the field is annihilated below.
+						fields = append(fields, fields[len(fields)-1])
+					}
+					continue
+				}
+
+				nextCount[ft]++
+				if nextCount[ft] == 1 {
+					next = append(next, embedded{typ: ft, index: index})
+				}
+			}
+		}
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		a, b := fields[i], fields[j]
+		if a.name != b.name {
+			return a.name < b.name
+		}
+		if len(a.index) != len(b.index) {
+			return len(a.index) < len(b.index)
+		}
+		if a.tag != b.tag {
+			return a.tag
+		}
+		return lessIndex(a.index, b.index)
+	})
+
+	// Keep only the dominant field for each name, which is the shallowest
+	// one, preferring tagged fields. Ties are dropped altogether.
+	out := fields[:0]
+	for advance, i := 0, 0; i < len(fields); i += advance {
+		fi := fields[i]
+		for advance = 1; i+advance < len(fields); advance++ {
+			if fields[i+advance].name != fi.name {
+				break
+			}
+		}
+		if advance > 1 {
+			fj := fields[i+1]
+			if len(fi.index) == len(fj.index) && fi.tag == fj.tag {
+				continue
+			}
+		}
+		out = append(out, fi)
+	}
+
+	fields = out
+	sort.Slice(fields, func(i, j int) bool {
+		return lessIndex(fields[i].index, fields[j].index)
+	})
+
+	f, _ := jsonFieldCache.LoadOrStore(t, fields)
+	return f.([]jsonField)
+}
+
+func lessIndex(a, b []int) bool {
+	for k, ak := range a {
+		if k >= len(b) {
+			return false
+		}
+		if ak != b[k] {
+			return ak < b[k]
+		}
+	}
+	return len(a) < len(b)
+}
+
+func hasJSONTagOption(opts, name string) bool {
+	for opts != "" {
+		var opt string
+		if i := strings.IndexByte(opts, ','); i >= 0 {
+			opt, opts = opts[:i], opts[i+1:]
+		} else {
+			opt, opts = opts, ""
+		}
+		if opt == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isValidJSONTag(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		switch {
+		case strings.ContainsRune("!#$%&()*+-./:;<=>?@[]^_{|}~ ", c):
+			// Backslash and quote chars are reserved, but otherwise any
+			// punctuation chars are allowed in a tag name.
+		case !unicode.IsLetter(c) && !unicode.IsDigit(c):
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,265 @@
+package strconvlen
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+type jsonTestInner struct {
+	A int    `json:"a"`
+	B string `json:"b,omitempty"`
+	C bool
+	d int
+}
+
+type jsonTestEmbedded struct {
+	E string
+	A int // hidden by jsonTestInner.A, which is tagged
+}
+
+type jsonTestEmbeddedPtr struct {
+	P float64 `json:"p,string"`
+}
+
+type jsonTestConflictA struct{ X int }
+type jsonTestConflictB struct{ X int }
+
+type jsonTestText string
+
+func (s jsonTestText) MarshalText() ([]byte, error) {
+	return []byte("<" + string(s) + ">"), nil
+}
+
+type jsonTestMarshaler struct{ V string }
+
+func (m *jsonTestMarshaler) MarshalJSON() ([]byte, error) {
+	return []byte("{ \"v\" : \"" + m.V + "\", \"html\": \"<&>\" }"), nil
+}
+
+var errJSONTestFail = errors.New("boom")
+
+type jsonTestFailMarshaler struct{}
+
+func (jsonTestFailMarshaler) MarshalJSON() ([]byte, error) {
+	return nil, errJSONTestFail
+}
+
+type jsonTestFailText struct{}
+
+func (jsonTestFailText) MarshalText() ([]byte, error) {
+	return nil, errJSONTestFail
+}
+
+type jsonTestZero struct{ n int }
+
+func (z jsonTestZero) IsZero() bool { return z.n < 0 }
+
+type jsonTestStruct struct {
+	jsonTestInner
+	jsonTestEmbedded
+	*jsonTestEmbeddedPtr
+	jsonTestConflictA
+	jsonTestConflictB
+
+	Skip      string       `json:"-"`
+	Dash      string       `json:"-,"`
+	Renamed   string       `json:"x<y>"`
+	Int       int64        `json:",string"`
+	IntPtr    *int         `json:",string"`
+	Bool      bool         `json:",string"`
+	Str       string       `json:",string"`
+	Slice     []int        `json:",string"`
+	Empty     []int        `json:",omitempty"`
+	Zero      jsonTestZero `json:",omitzero"`
+	ZeroTime  time.Time    `json:",omitzero"`
+	Number    json.Number
+	Bytes     []byte
+	Array     [3]uint8
+	Iface     interface{}
+	NilIface  interface{}
+	NilPtr    *jsonTestInner
+	NilMap    map[string]int
+	NilSlice  []string
+	Map       map[string]interface{}
+	IntMap    map[int]string
+	TextMap   map[jsonTestText]int
+	Text      jsonTestText
+	TextPtr   *jsonTestText
+	Marshaler jsonTestMarshaler
+	Values    []jsonTestMarshaler
+	Time      time.Time
+	IP        net.IP
+	F32       float32
+	F64       float64
+}
+
+func TestJSONLen(t *testing.T) {
+	s := newJSONTestStruct()
+	samples := []interface{}{
+		nil,
+		true,
+		-12345,
+		uint8(200),
+		3.25,
+		"hello <world>",
+		[]byte{},
+		[]string{},
+		map[string]int{},
+		struct{}{},
+		&s,
+		s,
+		[]jsonTestStruct{s, {}},
+		map[string]*jsonTestStruct{"s": &s, "nil": nil},
+		jsonTestMarshaler{V: "not addressable"},
+		&jsonTestMarshaler{V: "addressable"},
+		json.RawMessage(" [ 1 , 2 ] "),
+		struct {
+			F float64 `json:",omitempty"`
+		}{math.Copysign(0, -1)},
+	}
+	if !jsonEmptyMapKeyCheck {
+		samples = append(samples, map[[2]int]int(nil), map[[2]int]int{})
+	}
+	if jsonFloatMapKeys {
+		samples = append(samples, map[float64]int(nil),
+			map[float64]int{1e21: 1, math.Copysign(0, -1): 2, 1e-7: 3, 100: 4},
+			map[float32]int{0.1: 1})
+	}
+
+	for _, v := range samples {
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		l, err := JSONLen(v)
+		if err != nil || l != len(b) {
+			t.Errorf("expect JSONLen(%#v) == len(%s) == %d but got %d (err: %v)", v, b, len(b), l, err)
+		}
+	}
+}
+
+func TestJSONLenError(t *testing.T) {
+	type cycle struct {
+		Next *cycle
+	}
+	c := &cycle{}
+	c.Next = c
+
+	samples := []interface{}{
+		math.NaN(),
+		math.Inf(-1),
+		make(chan int),
+		func() {},
+		complex(1, 2),
+		map[[2]int]int{{1, 2}: 3},
+		json.Number("1x"),
+		jsonTestFailMarshaler{},
+		jsonTestFailText{},
+		c,
+		[]interface{}{1, struct{ F float32 }{float32(math.Inf(1))}},
+		map[float64]int{math.NaN(): 1},
+	}
+	if jsonEmptyMapKeyCheck {
+		samples = append(samples, map[float64]int(nil), map[[2]int]int{})
+	}
+
+	for _, v := range samples {
+		_, want := json.Marshal(v)
+		if want == nil {
+			t.Fatalf("expect json.Marshal(%T) to fail", v)
+		}
+		if _, err := JSONLen(v); err == nil {
+			t.Errorf("expect JSONLen(%T) to fail with %v", v, want)
+		}
+	}
+
+	// errors of failing marshalers name the failing method
+	marshalers := []interface{}{
+		jsonTestFailMarshaler{},
+		jsonTestFailText{},
+		struct{ T jsonTestFailText }{},
+	}
+
+	for _, v := range marshalers {
+		_, want := json.Marshal(v)
+		var wantErr *json.MarshalerError
+		if !errors.As(want, &wantErr) {
+			t.Fatalf("expect json.Marshal(%T) to fail with a *json.MarshalerError but got %v", v, want)
+		}
+
+		_, err := JSONLen(v)
+		var gotErr *json.MarshalerError
+		if !errors.As(err, &gotErr) {
+			t.Errorf("expect JSONLen(%T) to fail with a *json.MarshalerError but got %v", v, err)
+			continue
+		}
+		if gotErr.Err != wantErr.Err {
+			t.Errorf("expect JSONLen(%T) to fail with %v but got %v", v, wantErr.Err, gotErr.Err)
+		}
+		for _, method := range []string{"MarshalJSON", "MarshalText"} {
+			if strings.Contains(want.Error(), method) != strings.Contains(err.Error(), method) {
+				t.Errorf("expect JSONLen(%T) to fail with %q but got %q", v, want, err)
+			}
+		}
+	}
+}
+
+func TestJSONLenQuotedString(t *testing.T) {
+	type quoted struct {
+		S string `json:",string"`
+	}
+
+	samples := append(escapeSamples, randEscapeSamples(100)...)
+	samples = append(samples, strings.Repeat("\\\"", 3), " ")
+	for _, s := range samples {
+		b, err := json.Marshal(quoted{s})
+		if err != nil {
+			t.Fatal(err)
+		}
+		l, err := JSONLen(quoted{s})
+		if err != nil || l != len(b) {
+			t.Errorf("expect JSONLen(%q) == len(%s) == %d but got %d (err: %v)", s, b, len(b), l, err)
+		}
+	}
+}
+
+// helpers
+
+func newJSONTestStruct() jsonTestStruct {
+	n := 42
+	text := jsonTestText("ptr")
+	return jsonTestStruct{
+		jsonTestInner:       jsonTestInner{A: 1, C: true, d: 4},
+		jsonTestEmbedded:    jsonTestEmbedded{E: "e", A: 2},
+		jsonTestEmbeddedPtr: &jsonTestEmbeddedPtr{P: 1.5},
+		Skip:                "skip",
+		Dash:                "dash",
+		Renamed:             "renamed",
+		Int:                 -123,
+		IntPtr:              &n,
+		Bool:                true,
+		Str:                 "say \"hi\"\n<b>\xff",
+		Slice:               []int{1, 2},
+		Zero:                jsonTestZero{n: -1},
+		Number:              "1.5e10",
+		Bytes:               []byte("hello, world"),
+		Array:               [3]uint8{1, 2, 3},
+		Iface:               []interface{}{1, "two", 3.0, nil, map[string]int{"four": 4}},
+		Map:                 map[string]interface{}{"a": 1, "<b>": []string{"c"}, "": nil},
+		IntMap:              map[int]string{-1: "minus", 10: "ten"},
+		TextMap:             map[jsonTestText]int{"k": 1, "": 2},
+		Text:                "text",
+		TextPtr:             &text,
+		Marshaler:           jsonTestMarshaler{V: "m"},
+		Values:              []jsonTestMarshaler{{V: "a"}, {V: "b"}},
+		Time:                time.Date(2023, 12, 23, 1, 2, 3, 456, time.UTC),
+		IP:                  net.IPv4(127, 0, 0, 1),
+		F32:                 1e-7,
+		F64:                 math.Pi * 1e21,
+	}
+}
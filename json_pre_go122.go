@@ -0,0 +1,11 @@
+//go:build !go1.22
+// +build !go1.22
+
+package strconvlen
+
+// Differences in the output of encoding/json before Go 1.22.
+const (
+	// jsonShortEscapeBF reports whether backspace and form feed are escaped
+	// as \b and \f, rather than as \u0008 and \u000c.
+	jsonShortEscapeBF = false
+)
@@ -0,0 +1,11 @@
+//go:build !go1.24
+// +build !go1.24
+
+package strconvlen
+
+// Differences in the output of encoding/json before Go 1.24.
+const (
+	// jsonOmitZero reports whether the "omitzero" struct tag option is
+	// supported.
+	jsonOmitZero = false
+)
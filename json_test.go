@@ -0,0 +1,114 @@
+package strconvlen
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestJSONString(t *testing.T) {
+	samples := append(escapeSamples,
+		"\\backslash\\ \"quoted\"",
+		"\b\f\n\r\t\v",
+		"line\u2028para\u2029",
+		"<script>alert('&')</script>",
+	)
+	samples = append(samples, randEscapeSamples(100)...)
+
+	for _, escapeHTML := range []bool{true, false} {
+		for _, s := range samples {
+			var buf bytes.Buffer
+			enc := json.NewEncoder(&buf)
+			enc.SetEscapeHTML(escapeHTML)
+			if err := enc.Encode(s); err != nil {
+				t.Fatal(err)
+			}
+			want := buf.Len() - 1 // Encode appends a newline
+			if l := JSONString(s, escapeHTML); l != want {
+				t.Errorf("expect JSONString(%q, %v) == %d but got %d",
+					s, escapeHTML, want, l)
+			}
+		}
+	}
+}
+
+func TestJSONBool(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		want := len(mustMarshalJSON(t, b))
+		if l := JSONBool(b); l != want {
+			t.Errorf("expect JSONBool(%v) == %d but got %d", b, want, l)
+		}
+	}
+	if want := len(mustMarshalJSON(t, nil)); JSONNull != want {
+		t.Errorf("expect JSONNull == %d but got %d", want, JSONNull)
+	}
+}
+
+func TestJSONInt64(t *testing.T) {
+	for j := 1; j <= 19; j++ {
+		v := int64(randIntWithPlaces(j, 0, 0))
+		for _, n := range []int64{v, -v} {
+			want := len(mustMarshalJSON(t, n))
+			if l := JSONInt64(n); l != want {
+				t.Errorf("expect JSONInt64(%d) == %d but got %d", n, want, l)
+			}
+		}
+	}
+}
+
+func TestJSONUint64(t *testing.T) {
+	for j := 1; j <= 20; j++ {
+		v := randUint64WithPlaces(j)
+		want := len(mustMarshalJSON(t, v))
+		if l := JSONUint64(v); l != want {
+			t.Errorf("expect JSONUint64(%d) == %d but got %d", v, want, l)
+		}
+	}
+}
+
+func TestJSONFloat64(t *testing.T) {
+	samples := []float64{
+		0, math.Copysign(0, -1), 1, -1, 0.1, 1e-6, 9.99e-7, 1e-7, 1e-10,
+		1e20, 1e21, 123456.789, math.MaxFloat64, math.SmallestNonzeroFloat64,
+		math.MaxFloat32, math.SmallestNonzeroFloat32,
+	}
+	for i := 0; i < 100; i++ {
+		samples = append(samples, randFloat64())
+	}
+
+	for _, f := range samples {
+		want := len(mustMarshalJSON(t, f))
+		if l, err := JSONFloat64(f, 64); err != nil || l != want {
+			t.Errorf("expect JSONFloat64(%g, 64) == %d but got %d (err: %v)", f, want, l, err)
+		}
+
+		f32 := float32(f)
+		if math.IsInf(float64(f32), 0) {
+			continue
+		}
+		want = len(mustMarshalJSON(t, f32))
+		if l, err := JSONFloat64(float64(f32), 32); err != nil || l != want {
+			t.Errorf("expect JSONFloat64(%g, 32) == %d but got %d (err: %v)", f32, want, l, err)
+		}
+	}
+
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		_, want := json.Marshal(f)
+		_, err := JSONFloat64(f, 64)
+		if err == nil || err.Error() != want.Error() {
+			t.Errorf("expect JSONFloat64(%g, 64) to fail with %v but got %v", f, want, err)
+		}
+	}
+}
+
+// helpers
+
+func mustMarshalJSON(t *testing.T, v interface{}) []byte {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
@@ -0,0 +1,24 @@
+//go:build !goexperiment.jsonv2
+// +build !goexperiment.jsonv2
+
+package strconvlen
+
+// Differences in the output of the original encoding/json.
+const (
+	// jsonInvalidUTF8Len is the length of the replacement for each invalid
+	// UTF-8 byte in a JSON string, which is the escape sequence \ufffd.
+	jsonInvalidUTF8Len = 6
+	jsonInvalidUTF8Esc = 1 // backslashes in the replacement
+
+	// jsonTextMarshalerMapKeys reports whether map keys of string kind that
+	// implement encoding.TextMarshaler are marshaled as text, rather than as
+	// the underlying string.
+	jsonTextMarshalerMapKeys = false
+
+	// jsonEmptyMapKeyCheck reports whether nil and empty maps with an
+	// unsupported key type are rejected, rather than only maps with keys.
+	jsonEmptyMapKeyCheck = true
+
+	// jsonFloatMapKeys reports whether map keys of float kind are supported.
+	jsonFloatMapKeys = false
+)
@@ -0,0 +1,25 @@
+//go:build goexperiment.jsonv2
+// +build goexperiment.jsonv2
+
+package strconvlen
+
+// Differences in the output of encoding/json when it is backed by
+// encoding/json/v2.
+const (
+	// jsonInvalidUTF8Len is the length of the replacement for each invalid
+	// UTF-8 byte in a JSON string, which is utf8.RuneError as is.
+	jsonInvalidUTF8Len = 3
+	jsonInvalidUTF8Esc = 0 // backslashes in the replacement
+
+	// jsonTextMarshalerMapKeys reports whether map keys of string kind that
+	// implement encoding.TextMarshaler are marshaled as text, rather than as
+	// the underlying string.
+	jsonTextMarshalerMapKeys = true
+
+	// jsonEmptyMapKeyCheck reports whether nil and empty maps with an
+	// unsupported key type are rejected, rather than only maps with keys.
+	jsonEmptyMapKeyCheck = false
+
+	// jsonFloatMapKeys reports whether map keys of float kind are supported.
+	jsonFloatMapKeys = true
+)
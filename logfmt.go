@@ -0,0 +1,159 @@
+package strconvlen
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"unicode/utf8"
+)
+
+// LogfmtString returns the length of s after it is encoded as a logfmt value.
+// s is quoted if it contains spaces, control characters, equal signs, double
+// quotes or invalid UTF-8, or if it is "null", which would otherwise read as
+// a nil value. Inside quotes, characters are escaped like in JSON strings.
+func LogfmtString(s string) int {
+	if s == "null" {
+		return 6 // "null"
+	}
+	return logfmtValueLen(s)
+}
+
+// LogfmtPair returns the length of a logfmt key and value pair, i.e.
+// "key=value". Numbers are formatted like fmt.Sprint, nil is written as null,
+// and byte slices, encoding.TextMarshaler, error and fmt.Stringer values are
+// written as strings. Pointers are followed, and like go-logfmt, nil pointers
+// are written as null unless their methods handle nil receivers. Values of
+// other types are formatted by fmt.Sprint. If MarshalText fails, the length of
+// the error text as a logfmt value is returned instead.
+func LogfmtPair(key string, value interface{}) int {
+	return len(key) + 1 + logfmtAnyLen(value)
+}
+
+func logfmtAnyLen(value interface{}) int {
+	switch v := value.(type) {
+	case nil:
+		return 4 // null
+	case string:
+		return LogfmtString(v)
+	case []byte:
+		return logfmtValueLen(string(v))
+	case bool:
+		return Bool(v)
+	case int:
+		return Int(v, 10)
+	case int8:
+		return Int8(v, 10)
+	case int16:
+		return Int16(v, 10)
+	case int32:
+		return Int32(v, 10)
+	case int64:
+		return Int64(v, 10)
+	case uint:
+		return Uint(v, 10)
+	case uint8:
+		return Uint8(v, 10)
+	case uint16:
+		return Uint16(v, 10)
+	case uint32:
+		return Uint32(v, 10)
+	case uint64:
+		return Uint64(v, 10)
+	case float32:
+		return Float64(float64(v), 'g', -1, 32)
+	case float64:
+		return Float64(v, 'g', -1, 64)
+	case encoding.TextMarshaler:
+		var b []byte
+		var err error
+		if !logfmtNilSafe(v, func() { b, err = v.MarshalText() }) {
+			return 4 // null
+		}
+		if err != nil {
+			return logfmtAnyLen(err)
+		}
+		if b == nil {
+			return 4 // null
+		}
+		return logfmtValueLen(string(b))
+	case error:
+		var s string
+		if !logfmtNilSafe(v, func() { s = v.Error() }) {
+			return 4 // null
+		}
+		return LogfmtString(s)
+	case fmt.Stringer:
+		var s string
+		if !logfmtNilSafe(v, func() { s = v.String() }) {
+			return 4 // null
+		}
+		return LogfmtString(s)
+	default:
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				return 4 // null
+			}
+			return logfmtAnyLen(rv.Elem().Interface())
+		}
+		return logfmtValueLen(fmt.Sprint(v))
+	}
+}
+
+// logfmtNilSafe calls f, which calls a method of v, and reports false if f
+// panics because v is a nil pointer. Other panics are passed on.
+func logfmtNilSafe(v interface{}, f func()) (ok bool) {
+	defer func() {
+		if p := recover(); p != nil {
+			if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+				ok = false
+				return
+			}
+			panic(p)
+		}
+	}()
+	f()
+	return true
+}
+
+// logfmtValueLen returns the length of s after it is encoded as a logfmt
+// value, quoting it if needed.
+func logfmtValueLen(s string) int {
+	quoted := false
+	n := len(s)
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			i++
+			switch {
+			case c == '\\':
+				n++ // \\
+			case c == '"':
+				n++ // \"
+				quoted = true
+			case c == '\n', c == '\r', c == '\t':
+				n++ // \n \r \t
+				quoted = true
+			case c < ' ':
+				n += 5 // \u00XX
+				quoted = true
+			case c == ' ', c == '=':
+				quoted = true
+			}
+			continue
+		}
+
+		r, width := utf8.DecodeRuneInString(s[i:])
+		i += width
+		if r == utf8.RuneError {
+			quoted = true
+			if width == 1 {
+				n += 5 // \ufffd
+			}
+		}
+	}
+
+	if !quoted {
+		return len(s)
+	}
+	return n + 2
+}
@@ -0,0 +1,82 @@
+package strconvlen
+
+import (
+	"errors"
+	"math"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestLogfmtString(t *testing.T) {
+	samples := []struct {
+		s, want string
+	}{
+		{"", ""},
+		{"plain", "plain"},
+		{"null", `"null"`},
+		{`back\slash`, `back\slash`},
+		{"two words", `"two words"`},
+		{"a=b", `"a=b"`},
+		{`say "hi"`, `"say \"hi\""`},
+		{"tab\tnewline\n", `"tab\tnewline\n"`},
+		{"bell\a", `"bell\u0007"`},
+		{`mixed\ "`, `"mixed\\ \""`},
+		{"日本語", "日本語"},
+		{"bad\xff", `"bad\ufffd"`},
+		{"\ufffd", "\"\ufffd\""},
+	}
+
+	for _, s := range samples {
+		if l := LogfmtString(s.s); l != len(s.want) {
+			t.Errorf("expect LogfmtString(%q) == len(%s) == %d but got %d", s.s, s.want, len(s.want), l)
+		}
+	}
+}
+
+func TestLogfmtPair(t *testing.T) {
+	n, str, f := 5, "two words", 0.25
+	var pn *int
+	samples := []struct {
+		key   string
+		value interface{}
+		want  string
+	}{
+		{"nil", nil, "nil=null"},
+		{"msg", "hello world", `msg="hello world"`},
+		{"bytes", []byte("null"), "bytes=null"},
+		{"ok", false, "ok=false"},
+		{"n", -12345, "n=-12345"},
+		{"n", int8(-128), "n=-128"},
+		{"n", uint64(math.MaxUint64), "n=18446744073709551615"},
+		{"f", 0.1, "f=0.1"},
+		{"f", 1e21, "f=1e+21"},
+		{"f", float32(1.1), "f=1.1"},
+		{"f", math.Inf(-1), "f=-Inf"},
+		{"ip", net.IPv4(10, 0, 0, 1), "ip=10.0.0.1"},
+		{"err", errors.New("not found"), `err="not found"`},
+		{"dur", 1500 * time.Millisecond, "dur=1.5s"},
+		{"ptr", (*int)(nil), "ptr=null"},
+		{"time", (*time.Time)(nil), "time=null"},
+		{"ip", (*net.IPAddr)(nil), "ip=<nil>"},
+		{"n", &n, "n=5"},
+		{"s", &str, `s="two words"`},
+		{"f", &f, "f=0.25"},
+		{"pp", &pn, "pp=null"},
+		{"text", logfmtTestFailText{}, `text="boom boom"`},
+	}
+
+	for _, s := range samples {
+		if l := LogfmtPair(s.key, s.value); l != len(s.want) {
+			t.Errorf("expect LogfmtPair(%q, %v) == len(%s) == %d but got %d", s.key, s.value, s.want, len(s.want), l)
+		}
+	}
+}
+
+// helpers
+
+type logfmtTestFailText struct{}
+
+func (logfmtTestFailText) MarshalText() ([]byte, error) {
+	return nil, errors.New("boom boom")
+}
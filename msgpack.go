@@ -0,0 +1,103 @@
+package strconvlen
+
+import (
+	"math"
+)
+
+// MsgpackUint returns the length of n after it is encoded as a MessagePack
+// integer in its smallest representation.
+func MsgpackUint(n uint64) int {
+	if n < 1<<16 {
+		if n < 1<<8 {
+			if n < 1<<7 {
+				return 1 // positive fixint
+			}
+			return 2 // uint 8
+		}
+		return 3 // uint 16
+	}
+	if n < 1<<32 {
+		return 5 // uint 32
+	}
+	return 9 // uint 64
+}
+
+// MsgpackInt returns the length of n after it is encoded as a MessagePack
+// integer in its smallest representation. Non-negative integers are encoded
+// as unsigned integers.
+func MsgpackInt(n int64) int {
+	if n >= 0 {
+		return MsgpackUint(uint64(n))
+	}
+
+	if n >= -1<<15 {
+		if n >= -1<<7 {
+			if n >= -1<<5 {
+				return 1 // negative fixint
+			}
+			return 2 // int 8
+		}
+		return 3 // int 16
+	}
+	if n >= -1<<31 {
+		return 5 // int 32
+	}
+	return 9 // int 64
+}
+
+// MsgpackFloat returns the length of f after it is encoded as a MessagePack
+// float. If preferFloat32 is true, f is encoded as a float 32 whenever the
+// conversion is lossless.
+func MsgpackFloat(f float64, preferFloat32 bool) int {
+	if preferFloat32 && (float64(float32(f)) == f || math.IsNaN(f)) {
+		return 5 // float 32
+	}
+	return 9 // float 64
+}
+
+// MsgpackStr returns the length of a MessagePack string, including its
+// header, where the string is n bytes long.
+func MsgpackStr(n int) int {
+	if n < 0 || uint64(n) > math.MaxUint32 {
+		panic("strconvlen: illegal MsgpackStr length")
+	}
+
+	if n < 1<<8 {
+		if n < 1<<5 {
+			return 1 + n // fixstr
+		}
+		return 2 + n // str 8
+	}
+	if n < 1<<16 {
+		return 3 + n // str 16
+	}
+	return 5 + n // str 32
+}
+
+// MsgpackArrayHeader returns the length of the header of a MessagePack array
+// with n elements.
+func MsgpackArrayHeader(n int) int {
+	if n < 0 || uint64(n) > math.MaxUint32 {
+		panic("strconvlen: illegal MsgpackArrayHeader length")
+	}
+	return msgpackContainerHeader(n)
+}
+
+// MsgpackMapHeader returns the length of the header of a MessagePack map with
+// n key-value pairs.
+func MsgpackMapHeader(n int) int {
+	if n < 0 || uint64(n) > math.MaxUint32 {
+		panic("strconvlen: illegal MsgpackMapHeader length")
+	}
+	return msgpackContainerHeader(n)
+}
+
+func msgpackContainerHeader(n int) int {
+	if n < 1<<4 {
+		return 1 // fixarray, fixmap
+	}
+	if n < 1<<16 {
+		return 3 // array 16, map 16
+	}
+	return 5 // array 32, map 32
+}
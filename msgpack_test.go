@@ -0,0 +1,94 @@
+package strconvlen
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMsgpackUint(t *testing.T) {
+	samples := []struct {
+		v    uint64
+		want int
+	}{
+		{0, 1}, {127, 1},
+		{128, 2}, {255, 2},
+		{256, 3}, {math.MaxUint16, 3},
+		{math.MaxUint16 + 1, 5}, {math.MaxUint32, 5},
+		{math.MaxUint32 + 1, 9}, {math.MaxUint64, 9},
+	}
+	for _, s := range samples {
+		if l := MsgpackUint(s.v); l != s.want {
+			t.Errorf("expect MsgpackUint(%d) == %d but got %d", s.v, s.want, l)
+		}
+	}
+}
+
+func TestMsgpackInt(t *testing.T) {
+	samples := []struct {
+		v    int64
+		want int
+	}{
+		{0, 1}, {127, 1}, {-1, 1}, {-32, 1},
+		{128, 2}, {255, 2}, {-33, 2}, {math.MinInt8, 2},
+		{256, 3}, {math.MaxUint16, 3}, {math.MinInt8 - 1, 3}, {math.MinInt16, 3},
+		{math.MaxUint16 + 1, 5}, {math.MaxUint32, 5}, {math.MinInt16 - 1, 5}, {math.MinInt32, 5},
+		{math.MaxUint32 + 1, 9}, {math.MaxInt64, 9}, {math.MinInt32 - 1, 9}, {math.MinInt64, 9},
+	}
+	for _, s := range samples {
+		if l := MsgpackInt(s.v); l != s.want {
+			t.Errorf("expect MsgpackInt(%d) == %d but got %d", s.v, s.want, l)
+		}
+	}
+}
+
+func TestMsgpackFloat(t *testing.T) {
+	samples := []struct {
+		v    float64
+		want int
+	}{
+		{0, 5}, {1.5, 5}, {math.MaxFloat32, 5}, {math.Inf(-1), 5}, {math.NaN(), 5},
+		{0.1, 9}, {math.MaxFloat64, 9}, {math.SmallestNonzeroFloat64, 9},
+	}
+	for _, s := range samples {
+		if l := MsgpackFloat(s.v, true); l != s.want {
+			t.Errorf("expect MsgpackFloat(%g, true) == %d but got %d", s.v, s.want, l)
+		}
+		if l := MsgpackFloat(s.v, false); l != 9 {
+			t.Errorf("expect MsgpackFloat(%g, false) == 9 but got %d", s.v, l)
+		}
+	}
+}
+
+func TestMsgpackStr(t *testing.T) {
+	samples := []struct {
+		n, want int
+	}{
+		{0, 1}, {31, 32},
+		{32, 34}, {255, 257},
+		{256, 259}, {math.MaxUint16, math.MaxUint16 + 3},
+		{math.MaxUint16 + 1, math.MaxUint16 + 6},
+	}
+	for _, s := range samples {
+		if l := MsgpackStr(s.n); l != s.want {
+			t.Errorf("expect MsgpackStr(%d) == %d but got %d", s.n, s.want, l)
+		}
+	}
+}
+
+func TestMsgpackHeader(t *testing.T) {
+	samples := []struct {
+		n, want int
+	}{
+		{0, 1}, {15, 1},
+		{16, 3}, {math.MaxUint16, 3},
+		{math.MaxUint16 + 1, 5},
+	}
+	for _, s := range samples {
+		if l := MsgpackArrayHeader(s.n); l != s.want {
+			t.Errorf("expect MsgpackArrayHeader(%d) == %d but got %d", s.n, s.want, l)
+		}
+		if l := MsgpackMapHeader(s.n); l != s.want {
+			t.Errorf("expect MsgpackMapHeader(%d) == %d but got %d", s.n, s.want, l)
+		}
+	}
+}
@@ -0,0 +1,4 @@
+// Package netlen calculates the length of the string representations of
+// network addresses. It is kept apart from package strconvlen so that users of
+// the number formatting functions do not link package net.
+package netlen
@@ -0,0 +1,65 @@
+package netlen
+
+import (
+	"github.com/imacks/strconvlen"
+)
+
+// IPv4 returns the length of the dotted decimal form of IPv4 address a.
+func IPv4(a [4]byte) int {
+	return strconvlen.Uint8(a[0], 10) + strconvlen.Uint8(a[1], 10) +
+		strconvlen.Uint8(a[2], 10) + strconvlen.Uint8(a[3], 10) + 3
+}
+
+// IPv6 returns the same result as len(netip.AddrFrom16(a).String()), which
+// follows RFC 5952. The longest run of two or more zero groups is compressed
+// to "::", and IPv4-mapped addresses are written as "::ffff:" followed by the
+// dotted decimal IPv4 address.
+func IPv6(a [16]byte) int {
+	if isIPv4Mapped(a) {
+		return 7 + IPv4([4]byte{a[12], a[13], a[14], a[15]})
+	}
+
+	// Find the first longest run of zero groups, like net/netip does.
+	zeroStart, zeroEnd := -1, -1
+	for i := 0; i < 8; i++ {
+		j := i
+		for j < 8 && a[2*j] == 0 && a[2*j+1] == 0 {
+			j++
+		}
+		if l := j - i; l >= 2 && l > zeroEnd-zeroStart {
+			zeroStart, zeroEnd = i, j
+		}
+	}
+
+	n := 0
+	for i := 0; i < 8; i++ {
+		if i == zeroStart {
+			i = zeroEnd - 1
+			continue
+		}
+		n += strconvlen.Uint16(uint16(a[2*i])<<8|uint16(a[2*i+1]), 16)
+	}
+
+	if zeroStart < 0 {
+		return n + 7 // colons
+	}
+
+	// The groups before and after the zero run are joined by "::".
+	n += 2
+	if zeroStart > 1 {
+		n += zeroStart - 1
+	}
+	if zeroEnd < 7 {
+		n += 7 - zeroEnd
+	}
+	return n
+}
+
+func isIPv4Mapped(a [16]byte) bool {
+	for i := 0; i < 10; i++ {
+		if a[i] != 0 {
+			return false
+		}
+	}
+	return a[10] == 0xff && a[11] == 0xff
+}
@@ -0,0 +1,67 @@
+//go:build go1.18
+// +build go1.18
+
+package netlen
+
+import (
+	"math/rand"
+	"net/netip"
+	"testing"
+)
+
+func TestIPv4(t *testing.T) {
+	samples := [][4]byte{{0, 0, 0, 0}, {255, 255, 255, 255}, {10, 0, 0, 1}, {192, 168, 100, 10}}
+	for i := 0; i < 100; i++ {
+		samples = append(samples, [4]byte{randIPByte(), randIPByte(), randIPByte(), randIPByte()})
+	}
+
+	for _, a := range samples {
+		vstr := netip.AddrFrom4(a).String()
+		if l := IPv4(a); l != len(vstr) {
+			t.Errorf("expect IPv4(%v) == len(%q) == %d but got %d", a, vstr, len(vstr), l)
+		}
+	}
+}
+
+func TestIPv6(t *testing.T) {
+	samples := []string{
+		"::", "::1", "1::", "2001:db8::1", "2001:db8:0:0:1:0:0:1", "2001:0:0:1::1",
+		"2001:db8:85a3::8a2e:370:7334", "fe80::1:2:3:4", "1:0:2:0:3:0:4:0", "1:2:3:4:5:6:7:8",
+		"ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff", "::ffff:192.0.2.1", "::ffff:0.0.0.0",
+		"::192.0.2.1", "64:ff9b::192.0.2.33",
+	}
+	for _, s := range samples {
+		a := netip.MustParseAddr(s).As16()
+		vstr := netip.AddrFrom16(a).String()
+		if l := IPv6(a); l != len(vstr) {
+			t.Errorf("expect IPv6(%s) == len(%q) == %d but got %d", s, vstr, len(vstr), l)
+		}
+	}
+
+	for i := 0; i < 1000; i++ {
+		var a [16]byte
+		for j := 0; j < 16; j += 2 {
+			// favour zero groups to exercise run compression
+			if rand.Intn(2) == 0 {
+				a[j], a[j+1] = randIPByte(), randIPByte()
+			}
+		}
+		vstr := netip.AddrFrom16(a).String()
+		if l := IPv6(a); l != len(vstr) {
+			t.Errorf("expect IPv6(%v) == len(%q) == %d but got %d", a, vstr, len(vstr), l)
+		}
+	}
+}
+
+// helpers
+
+// randIPByte returns a random byte, where 0 and 255 are more likely.
+func randIPByte() byte {
+	switch rand.Intn(4) {
+	case 0:
+		return 0
+	case 1:
+		return 255
+	}
+	return byte(rand.Intn(256))
+}
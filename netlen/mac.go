@@ -0,0 +1,32 @@
+package netlen
+
+import (
+	"net"
+)
+
+// HardwareAddr returns the same result as len(mac.String()), which works for
+// both EUI-48 and EUI-64 addresses.
+func HardwareAddr(mac net.HardwareAddr) int {
+	return HardwareAddrSep(mac, ':')
+}
+
+// HardwareAddrSep returns the length of mac in hex with the separator sep.
+// Separators ':' and '-' are put between every byte, like "00-00-5e-00-53-01",
+// while '.' is put between every two bytes in Cisco style, like
+// "0000.5e00.5301". If sep is 0, the hex digits are not separated at all.
+func HardwareAddrSep(mac net.HardwareAddr, sep byte) int {
+	if len(mac) == 0 {
+		return 0
+	}
+
+	switch sep {
+	case ':', '-':
+		return len(mac)*3 - 1
+	case '.':
+		return len(mac)*2 + (len(mac)+1)/2 - 1
+	case 0:
+		return len(mac) * 2
+	default:
+		panic("netlen: illegal HardwareAddrSep separator")
+	}
+}
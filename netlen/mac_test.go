@@ -0,0 +1,50 @@
+package netlen
+
+import (
+	"net"
+	"strings"
+	"testing"
+)
+
+func TestHardwareAddr(t *testing.T) {
+	samples := []string{
+		"00:00:5e:00:53:01",
+		"02:00:5e:10:00:00:00:01",
+		"00:00:00:00:fe:80:00:00:00:00:00:00:02:00:5e:10:00:00:00:01",
+	}
+
+	for _, s := range samples {
+		mac, err := net.ParseMAC(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		vstr := mac.String()
+		if l := HardwareAddr(mac); l != len(vstr) {
+			t.Errorf("expect HardwareAddr(%s) == len(%q) == %d but got %d", s, vstr, len(vstr), l)
+		}
+
+		hex := strings.ReplaceAll(vstr, ":", "")
+		var cisco []string
+		for i := 0; i < len(hex); i += 4 {
+			cisco = append(cisco, hex[i:i+4])
+		}
+		wants := map[byte]string{
+			'-': strings.ReplaceAll(vstr, ":", "-"),
+			'.': strings.Join(cisco, "."),
+			0:   hex,
+		}
+		for sep, want := range wants {
+			if l := HardwareAddrSep(mac, sep); l != len(want) {
+				t.Errorf("expect HardwareAddrSep(%s, %q) == len(%q) == %d but got %d", s, sep, want, len(want), l)
+			}
+		}
+	}
+
+	if l := HardwareAddr(nil); l != 0 {
+		t.Errorf("expect HardwareAddr(nil) == 0 but got %d", l)
+	}
+	if l := HardwareAddrSep(net.HardwareAddr{1, 2, 3}, '.'); l != len("0102.03") {
+		t.Errorf("expect HardwareAddrSep(01:02:03, '.') == 7 but got %d", l)
+	}
+}
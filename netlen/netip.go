@@ -0,0 +1,46 @@
+//go:build go1.18
+// +build go1.18
+
+package netlen
+
+import (
+	"net/netip"
+
+	"github.com/imacks/strconvlen"
+)
+
+// NetipAddr returns the same result as len(ip.String()).
+func NetipAddr(ip netip.Addr) int {
+	switch {
+	case !ip.IsValid():
+		return 10 // invalid IP
+	case ip.Is4():
+		return IPv4(ip.As4())
+	}
+
+	n := IPv6(ip.As16())
+	if zone := ip.Zone(); zone != "" {
+		n += 1 + len(zone) // %zone
+	}
+	return n
+}
+
+// AddrPort returns the same result as len(p.String()).
+func AddrPort(p netip.AddrPort) int {
+	ip := p.Addr()
+	switch {
+	case !ip.IsValid():
+		return 16 // invalid AddrPort
+	case ip.Is4():
+		return IPv4(ip.As4()) + 1 + strconvlen.Uint16(p.Port(), 10)
+	}
+	return 1 + NetipAddr(ip) + 2 + strconvlen.Uint16(p.Port(), 10) // [ip]:port
+}
+
+// Prefix returns the same result as len(p.String()).
+func Prefix(p netip.Prefix) int {
+	if !p.IsValid() {
+		return 14 // invalid Prefix
+	}
+	return NetipAddr(p.Addr()) + 1 + strconvlen.Uint8(uint8(p.Bits()), 10)
+}
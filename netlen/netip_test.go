@@ -0,0 +1,42 @@
+//go:build go1.18
+// +build go1.18
+
+package netlen
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestNetipAddr(t *testing.T) {
+	samples := []netip.Addr{
+		{},
+		netip.MustParseAddr("127.0.0.1"),
+		netip.MustParseAddr("::1"),
+		netip.MustParseAddr("fe80::1%eth0"),
+		netip.MustParseAddr("::ffff:10.0.0.1"),
+		netip.MustParseAddr("::ffff:10.0.0.1%enp5s0"),
+	}
+	for _, ip := range samples {
+		vstr := ip.String()
+		if l := NetipAddr(ip); l != len(vstr) {
+			t.Errorf("expect NetipAddr(%s) == len(%q) == %d but got %d", vstr, vstr, len(vstr), l)
+		}
+
+		for _, port := range []uint16{0, 80, 65535} {
+			ap := netip.AddrPortFrom(ip, port)
+			vstr := ap.String()
+			if l := AddrPort(ap); l != len(vstr) {
+				t.Errorf("expect AddrPort(%s) == len(%q) == %d but got %d", vstr, vstr, len(vstr), l)
+			}
+		}
+
+		for _, bits := range []int{-1, 0, 8, 32, 64, 128} {
+			p := netip.PrefixFrom(ip, bits)
+			vstr := p.String()
+			if l := Prefix(p); l != len(vstr) {
+				t.Errorf("expect Prefix(%s) == len(%q) == %d but got %d", vstr, vstr, len(vstr), l)
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package strconvlen
+
+import (
+	"math/bits"
+)
+
+// powTable holds precomputed values to find the number of digits of a uint64
+// in a particular base, without dividing or looping.
+type powTable struct {
+	// minDigits[i] is the number of digits of the smallest number with a bit
+	// length of i, except that minDigits[0] is 1 for zero.
+	minDigits [65]uint8
+	// pows[i] is base^i, or 0 if it overflows a uint64.
+	pows [65]uint64
+}
+
+// powTables holds the power tables of bases 2 to 62.
+var powTables = func() (tables [63]powTable) {
+	for base := 2; base < len(tables); base++ {
+		t := &tables[base]
+
+		bbase := uint64(base)
+		t.pows[0] = 1
+		for i := 1; i < len(t.pows); i++ {
+			hi, lo := bits.Mul64(t.pows[i-1], bbase)
+			if hi != 0 {
+				break
+			}
+			t.pows[i] = lo
+		}
+
+		t.minDigits[0] = 1
+		d := 1
+		for i := 1; i < len(t.minDigits); i++ {
+			n := uint64(1) << (i - 1)
+			for t.pows[d] != 0 && n >= t.pows[d] {
+				d++
+			}
+			t.minDigits[i] = uint8(d)
+		}
+	}
+	return tables
+}()
+
+// powLen returns the number of digits of n in base, where base is between 2
+// and 62.
+func powLen(n uint64, base int) int {
+	if n < uint64(base) {
+		return 1 // cheaper than the table lookup
+	}
+
+	// All numbers with the same bit length have either the same number of
+	// digits as the smallest of them, or one more. A single comparison with
+	// the next power of base tells which.
+	t := &powTables[base]
+	d := int(t.minDigits[bits.Len64(n)])
+	if p := t.pows[d]; p != 0 && n >= p {
+		d++
+	}
+	return d
+}
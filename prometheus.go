@@ -0,0 +1,58 @@
+package strconvlen
+
+import (
+	"math"
+)
+
+// Label is a Prometheus label name and value pair.
+type Label struct {
+	Name  string
+	Value string
+}
+
+// PromSample returns the length of a sample line in the Prometheus text
+// exposition format, i.e. `name{label="value",...} value timestamp\n`. The
+// labels are omitted if there are none, and the millisecond timestamp is
+// omitted if ts is nil.
+func PromSample(name string, labels []Label, value float64, ts *int64) int {
+	n := len(name)
+	if len(labels) > 0 {
+		n += 1 + len(labels) // { and commas or }
+		for _, l := range labels {
+			n += len(l.Name) + 3 + promLabelValueLen(l.Value) // name="value"
+		}
+	}
+
+	n += 1 + promFloatLen(value)
+	if ts != nil {
+		n += 1 + Int64(*ts, 10)
+	}
+	return n + 1 // newline
+}
+
+// promLabelValueLen returns the length of v after backslashes, double quotes
+// and newlines are escaped.
+func promLabelValueLen(v string) int {
+	n := len(v)
+	for i := 0; i < len(v); i++ {
+		switch v[i] {
+		case '\\', '"', '\n':
+			n++
+		}
+	}
+	return n
+}
+
+// promFloatLen returns the length of f in the Prometheus text format, which
+// spells out special values as +Inf, -Inf and NaN.
+func promFloatLen(f float64) int {
+	switch {
+	case f == 0:
+		return 1 // negative zero is written as 0
+	case math.IsNaN(f):
+		return 3 // NaN
+	case math.IsInf(f, 0):
+		return 4 // +Inf, -Inf
+	}
+	return Float64(f, 'g', -1, 64)
+}
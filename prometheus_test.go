@@ -0,0 +1,62 @@
+package strconvlen
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestPromSample(t *testing.T) {
+	samples := []struct {
+		name   string
+		labels []Label
+		value  float64
+		ts     *int64
+		want   string
+	}{
+		{"up", nil, 1, nil, "up 1\n"},
+		{"up", []Label{}, 0, nil, "up 0\n"},
+		{"neg_zero", nil, math.Copysign(0, -1), nil, "neg_zero 0\n"},
+		{"nan", nil, math.NaN(), nil, "nan NaN\n"},
+		{"inf", nil, math.Inf(1), nil, "inf +Inf\n"},
+		{"inf", nil, math.Inf(-1), nil, "inf -Inf\n"},
+		{"big", nil, 1e21, nil, "big 1e+21\n"},
+		{
+			"http_requests_total",
+			[]Label{{"method", "post"}, {"code", "200"}},
+			1027, int64Ptr(1395066363000),
+			`http_requests_total{method="post",code="200"} 1027 1395066363000` + "\n",
+		},
+		{
+			"msdos_file_access_time_seconds",
+			[]Label{{"path", `C:\DIR\FILE.TXT`}, {"error", "Cannot find file:\n\"FILE.TXT\""}},
+			1.458255915e9, int64Ptr(-1),
+			`msdos_file_access_time_seconds{path="C:\\DIR\\FILE.TXT",error="Cannot find file:\n\"FILE.TXT\""} 1.458255915e+09 -1` + "\n",
+		},
+		{"epoch", nil, 1, int64Ptr(0), "epoch 1 0\n"},
+	}
+
+	for _, s := range samples {
+		if l := PromSample(s.name, s.labels, s.value, s.ts); l != len(s.want) {
+			t.Errorf("expect PromSample() == len(%q) == %d but got %d", s.want, len(s.want), l)
+		}
+	}
+
+	for i := 0; i < 100; i++ {
+		f := randFloat64()
+		ts := int64(randIntWithPlaces(13, 0, 0))
+		labels := []Label{{"value", randEscapeSamples(1)[0]}}
+		want := "m{value=\"" + promEscaper.Replace(labels[0].Value) + "\"} " +
+			strconv.FormatFloat(f, 'g', -1, 64) + " " + strconv.FormatInt(ts, 10) + "\n"
+		if l := PromSample("m", labels, f, &ts); l != len(want) {
+			t.Errorf("expect PromSample() == len(%q) == %d but got %d", want, len(want), l)
+		}
+	}
+}
+
+// helpers
+
+func int64Ptr(n int64) *int64 { return &n }
+
+var promEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
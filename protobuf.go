@@ -0,0 +1,64 @@
+package strconvlen
+
+// Fixed32 and Fixed64 are the encoded lengths of the protobuf fixed32, sfixed32
+// and float types, and the fixed64, sfixed64 and double types respectively.
+const (
+	Fixed32 = 4
+	Fixed64 = 8
+)
+
+// Varint returns the length of n after it is encoded as a protobuf base 128
+// varint.
+func Varint(n uint64) int {
+	// Each byte holds 7 bits of n, so this is Uint64(n, 128) if strconv
+	// supported such a base.
+	if n < 1<<35 {
+		if n < 1<<14 {
+			if n < 1<<7 {
+				return 1
+			}
+			return 2
+		}
+		if n < 1<<28 {
+			if n < 1<<21 {
+				return 3
+			}
+			return 4
+		}
+		return 5
+	}
+	if n < 1<<56 {
+		if n < 1<<49 {
+			if n < 1<<42 {
+				return 6
+			}
+			return 7
+		}
+		return 8
+	}
+	if n < 1<<63 {
+		return 9
+	}
+	return 10
+}
+
+// ZigZag64 returns the length of n after it is zigzag encoded as a protobuf
+// sint64 varint.
+func ZigZag64(n int64) int {
+	return Varint(uint64(n<<1) ^ uint64(n>>63))
+}
+
+// Tag returns the length of a protobuf field tag, which is a varint of the
+// field number and wire type.
+func Tag(fieldNum int, wireType int) int {
+	if fieldNum < 1 || fieldNum > 1<<29-1 {
+		panic("strconvlen: illegal Tag field number")
+	}
+	if wireType < 0 || wireType > 5 {
+		panic("strconvlen: illegal Tag wire type")
+	}
+
+	// The wire type takes up the lower 3 bits, so field numbers below 16 fit
+	// into a single byte.
+	return Varint(uint64(fieldNum)<<3 | uint64(wireType))
+}
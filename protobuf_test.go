@@ -0,0 +1,89 @@
+package strconvlen
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestVarint(t *testing.T) {
+	var buf [binary.MaxVarintLen64]byte
+
+	for i := 0; i <= 64; i++ {
+		for _, v := range randUint64WithBits(i) {
+			want := binary.PutUvarint(buf[:], v)
+			if l := Varint(v); l != want {
+				t.Errorf("expect Varint(%d) == %d but got %d", v, want, l)
+			}
+		}
+	}
+}
+
+func TestZigZag64(t *testing.T) {
+	var buf [binary.MaxVarintLen64]byte
+
+	for i := 0; i <= 64; i++ {
+		for _, v := range randUint64WithBits(i) {
+			for _, n := range []int64{int64(v), -int64(v)} {
+				zz := uint64(n<<1) ^ uint64(n>>63)
+				want := binary.PutUvarint(buf[:], zz)
+				if l := ZigZag64(n); l != want {
+					t.Errorf("expect ZigZag64(%d) == %d but got %d", n, want, l)
+				}
+			}
+		}
+	}
+	if l := ZigZag64(math.MinInt64); l != 10 {
+		t.Errorf("expect ZigZag64(%d) == 10 but got %d", int64(math.MinInt64), l)
+	}
+}
+
+func TestTag(t *testing.T) {
+	samples := []struct {
+		fieldNum, wireType, want int
+	}{
+		{1, 0, 1},
+		{15, 5, 1},
+		{16, 0, 2},
+		{2047, 2, 2},
+		{2048, 2, 3},
+		{262143, 1, 3},
+		{262144, 1, 4},
+		{33554431, 0, 4},
+		{33554432, 0, 5},
+		{1<<29 - 1, 5, 5},
+	}
+	for _, s := range samples {
+		if l := Tag(s.fieldNum, s.wireType); l != s.want {
+			t.Errorf("expect Tag(%d, %d) == %d but got %d", s.fieldNum, s.wireType, s.want, l)
+		}
+	}
+
+	for _, s := range [][2]int{{0, 0}, {1 << 29, 0}, {1, -1}, {1, 6}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expect Tag(%d, %d) to panic", s[0], s[1])
+				}
+			}()
+			Tag(s[0], s[1])
+		}()
+	}
+}
+
+// helpers
+
+// randUint64WithBits returns the smallest and largest unsigned integers with
+// the specified bit length, and a random one in between.
+func randUint64WithBits(bits int) []uint64 {
+	if bits < 0 || bits > 64 {
+		panic("bits must be between 0-64")
+	}
+	if bits == 0 {
+		return []uint64{0}
+	}
+	lo := uint64(1) << (bits - 1)
+	hi := lo | (lo - 1)
+	return []uint64{lo, hi, lo | (rand.Uint64() & (lo - 1))}
+}
@@ -0,0 +1,56 @@
+package strconvlen
+
+import (
+	"math"
+)
+
+// RESPBulkString returns the length of a RESP bulk string frame with a
+// payload of n bytes, i.e. "$<n>\r\n<payload>\r\n". If n is -1, it returns the
+// length of the RESP2 null bulk string "$-1\r\n".
+func RESPBulkString(n int) int {
+	if n < -1 {
+		panic("strconvlen: illegal RESPBulkString length")
+	}
+	if n == -1 {
+		return 5
+	}
+	return 1 + Int(n, 10) + 2 + n + 2
+}
+
+// RESPInteger returns the length of a RESP integer frame, i.e. ":<n>\r\n".
+func RESPInteger(n int64) int {
+	return 1 + Int64(n, 10) + 2
+}
+
+// RESPArrayHeader returns the length of the header of a RESP array frame with
+// n elements, i.e. "*<n>\r\n". If n is -1, it returns the length of the RESP2
+// null array "*-1\r\n".
+func RESPArrayHeader(n int) int {
+	if n < -1 {
+		panic("strconvlen: illegal RESPArrayHeader length")
+	}
+	return 1 + Int(n, 10) + 2
+}
+
+// RESPDouble returns the length of a RESP3 double frame, i.e. ",<f>\r\n",
+// where f is formatted by strconv.FormatFloat(f, 'g', -1, 64), or spelled
+// "inf", "-inf" or "nan".
+func RESPDouble(f float64) int {
+	switch {
+	case math.IsNaN(f), math.IsInf(f, 1):
+		return 1 + 3 + 2
+	case math.IsInf(f, -1):
+		return 1 + 4 + 2
+	}
+	return 1 + Float64(f, 'g', -1, 64) + 2
+}
+
+// RESPCommand returns the length of a command sent to a RESP server, which is
+// an array of bulk strings.
+func RESPCommand(args ...[]byte) int {
+	n := RESPArrayHeader(len(args))
+	for _, arg := range args {
+		n += RESPBulkString(len(arg))
+	}
+	return n
+}
@@ -0,0 +1,69 @@
+package strconvlen
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestRESPBulkString(t *testing.T) {
+	if l := RESPBulkString(-1); l != len("$-1\r\n") {
+		t.Errorf("expect RESPBulkString(-1) == 5 but got %d", l)
+	}
+	for _, n := range []int{0, 1, 9, 10, 99, 100, 12345} {
+		vstr := fmt.Sprintf("$%d\r\n%s\r\n", n, strings.Repeat("x", n))
+		if l := RESPBulkString(n); l != len(vstr) {
+			t.Errorf("expect RESPBulkString(%d) == %d but got %d", n, len(vstr), l)
+		}
+	}
+}
+
+func TestRESPInteger(t *testing.T) {
+	for j := 1; j <= 19; j++ {
+		v := int64(randIntWithPlaces(j, 0, 0))
+		for _, n := range []int64{v, -v} {
+			vstr := fmt.Sprintf(":%d\r\n", n)
+			if l := RESPInteger(n); l != len(vstr) {
+				t.Errorf("expect RESPInteger(%d) == len(%q) == %d but got %d", n, vstr, len(vstr), l)
+			}
+		}
+	}
+}
+
+func TestRESPArrayHeader(t *testing.T) {
+	for _, n := range []int{-1, 0, 1, 9, 10, 99, 100, 12345} {
+		vstr := fmt.Sprintf("*%d\r\n", n)
+		if l := RESPArrayHeader(n); l != len(vstr) {
+			t.Errorf("expect RESPArrayHeader(%d) == len(%q) == %d but got %d", n, vstr, len(vstr), l)
+		}
+	}
+}
+
+func TestRESPDouble(t *testing.T) {
+	samples := map[float64]string{
+		math.Inf(1):  ",inf\r\n",
+		math.Inf(-1): ",-inf\r\n",
+		math.NaN():   ",nan\r\n",
+	}
+	for _, f := range []float64{0, 1, -1.5, 3.14159, 1e21, -1e-7, math.MaxFloat64} {
+		samples[f] = "," + strconv.FormatFloat(f, 'g', -1, 64) + "\r\n"
+	}
+	for f, vstr := range samples {
+		if l := RESPDouble(f); l != len(vstr) {
+			t.Errorf("expect RESPDouble(%g) == len(%q) == %d but got %d", f, vstr, len(vstr), l)
+		}
+	}
+}
+
+func TestRESPCommand(t *testing.T) {
+	args := [][]byte{[]byte("SET"), []byte("key"), []byte(strings.Repeat("v", 100))}
+	vstr := "*3\r\n$3\r\nSET\r\n$3\r\nkey\r\n$100\r\n" + strings.Repeat("v", 100) + "\r\n"
+	if l := RESPCommand(args...); l != len(vstr) {
+		t.Errorf("expect RESPCommand() == %d but got %d", len(vstr), l)
+	}
+	if l := RESPCommand(); l != len("*0\r\n") {
+		t.Errorf("expect RESPCommand() == 4 but got %d", l)
+	}
+}
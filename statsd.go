@@ -0,0 +1,26 @@
+package strconvlen
+
+// StatsDLine returns the length of a StatsD or DogStatsD metric line, i.e.
+// "name:value|type|@rate|#tag1,tag2", without a trailing newline. typ is the
+// metric type, such as 'c', 'g', 'h', 'd' or 's', where 'm' stands for the
+// "ms" timer type. Values and sample rates are formatted by
+// strconv.FormatFloat(f, 'f', -1, 64), and the sample rate is omitted unless
+// it is below 1. The tags section is omitted if there are no tags.
+func StatsDLine(name string, value float64, typ byte, rate float64, tags []string) int {
+	n := len(name) + 1 + Float64(value, 'f', -1, 64) + 2 // name:value|type
+	if typ == 'm' {
+		n++ // ms
+	}
+
+	if rate < 1 {
+		n += 2 + Float64(rate, 'f', -1, 64) // |@rate
+	}
+
+	if len(tags) > 0 {
+		n += 2 + len(tags) - 1 // |# and commas
+		for _, tag := range tags {
+			n += len(tag)
+		}
+	}
+	return n
+}
@@ -0,0 +1,33 @@
+package strconvlen
+
+import (
+	"testing"
+)
+
+func TestStatsDLine(t *testing.T) {
+	samples := []struct {
+		name  string
+		value float64
+		typ   byte
+		rate  float64
+		tags  []string
+		want  string
+	}{
+		{"page.views", 1, 'c', 1, nil, "page.views:1|c"},
+		{"fuel.level", 0.5, 'g', 1, []string{}, "fuel.level:0.5|g"},
+		{"song.length", 240, 'h', 0.5, nil, "song.length:240|h|@0.5"},
+		{"users.uniques", 1234, 's', 1, nil, "users.uniques:1234|s"},
+		{"glork", 320, 'm', 0.1, nil, "glork:320|ms|@0.1"},
+		{"queue.size", -1e-7, 'g', 2, []string{"env:prod"}, "queue.size:-0.0000001|g|#env:prod"},
+		{
+			"request.latency", 12.25, 'd', 0.25, []string{"env:prod", "service:api", "canary"},
+			"request.latency:12.25|d|@0.25|#env:prod,service:api,canary",
+		},
+	}
+
+	for _, s := range samples {
+		if l := StatsDLine(s.name, s.value, s.typ, s.rate, s.tags); l != len(s.want) {
+			t.Errorf("expect StatsDLine() == len(%q) == %d but got %d", s.want, len(s.want), l)
+		}
+	}
+}
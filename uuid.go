@@ -0,0 +1,42 @@
+package strconvlen
+
+// UUIDEncoding is a string encoding of a UUID.
+type UUIDEncoding int
+
+// UUID string encodings.
+const (
+	// UUIDCanonical is the hyphenated 8-4-4-4-12 hex form, such as
+	// "f47ac10b-58cc-4372-a567-0e02b2c3d479".
+	UUIDCanonical UUIDEncoding = iota
+	// UUIDURN is the canonical form with the "urn:uuid:" prefix.
+	UUIDURN
+	// UUIDBraced is the canonical form in curly braces.
+	UUIDBraced
+	// UUIDHex is the canonical form without hyphens.
+	UUIDHex
+	// UUIDBase32 is Crockford's base32 without padding, as used by ULID.
+	UUIDBase32
+	// UUIDBase58 is base58 with the Bitcoin alphabet, where each leading zero
+	// byte is encoded as '1'. Its length depends on the value of the UUID.
+	UUIDBase58
+)
+
+// UUID returns the length of UUID u in encoding enc.
+func UUID(u [16]byte, enc UUIDEncoding) int {
+	switch enc {
+	case UUIDCanonical:
+		return 36
+	case UUIDURN:
+		return 9 + 36
+	case UUIDBraced:
+		return 1 + 36 + 1
+	case UUIDHex:
+		return 32
+	case UUIDBase32:
+		return 26 // ceil(128 / 5)
+	case UUIDBase58:
+		return Base58(u[:])
+	default:
+		panic("strconvlen: illegal UUID encoding")
+	}
+}
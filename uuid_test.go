@@ -0,0 +1,64 @@
+package strconvlen
+
+import (
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestUUID(t *testing.T) {
+	samples := [][16]byte{{}, {15: 1}, {0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}}
+	for i := 0; i < 100; i++ {
+		var u [16]byte
+		rand.Read(u[rand.Intn(4):])
+		samples = append(samples, u)
+	}
+
+	for _, u := range samples {
+		hex := strings.ToLower(new(big.Int).SetBytes(u[:]).Text(16))
+		hex = strings.Repeat("0", 32-len(hex)) + hex
+		canonical := hex[:8] + "-" + hex[8:12] + "-" + hex[12:16] + "-" + hex[16:20] + "-" + hex[20:]
+
+		wants := map[UUIDEncoding]string{
+			UUIDCanonical: canonical,
+			UUIDURN:       "urn:uuid:" + canonical,
+			UUIDBraced:    "{" + canonical + "}",
+			UUIDHex:       hex,
+			UUIDBase32:    "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			UUIDBase58:    refRadixEncode(u[:], string(Base58Alphabet)),
+		}
+		for enc, want := range wants {
+			if l := UUID(u, enc); l != len(want) {
+				t.Errorf("expect UUID(%x, %d) == len(%q) == %d but got %d", u, enc, want, len(want), l)
+			}
+		}
+	}
+}
+
+// helpers
+
+// refRadixEncode encodes src as a big-endian number with alphabet, where each
+// leading zero byte is encoded as the first character of alphabet.
+func refRadixEncode(src []byte, alphabet string) string {
+	zeros := 0
+	for zeros < len(src) && src[zeros] == 0 {
+		zeros++
+	}
+
+	var digits []byte
+	n := new(big.Int).SetBytes(src)
+	base := big.NewInt(int64(len(alphabet)))
+	rem := new(big.Int)
+	for n.Sign() > 0 {
+		n.DivMod(n, base, rem)
+		digits = append(digits, alphabet[rem.Int64()])
+	}
+	for i := 0; i < zeros; i++ {
+		digits = append(digits, alphabet[0])
+	}
+	for i, j := 0, len(digits)-1; i < j; i, j = i+1, j-1 {
+		digits[i], digits[j] = digits[j], digits[i]
+	}
+	return string(digits)
+}